@@ -0,0 +1,298 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/idna"
+)
+
+// Addr returns the address as a [netip.Addr].
+//
+// An IPv4-mapped IPv6 address such as "::ffff:192.0.2.1" is unmapped.
+func (u IPv4) Addr() (netip.Addr, error) {
+	addr, err := netip.ParseAddr(string(u))
+	if err != nil || !isIPv4(string(u)) {
+		return netip.Addr{}, fmt.Errorf("invalid ipv4 %q: %w", string(u), ErrFormat)
+	}
+
+	return addr.Unmap(), nil
+}
+
+// IPv4FromAddr returns the [IPv4] representation of an address.
+//
+// An IPv4-mapped IPv6 address is unmapped. Other IPv6 addresses are rejected.
+func IPv4FromAddr(addr netip.Addr) (IPv4, error) {
+	addr = addr.Unmap()
+	if !addr.Is4() {
+		return "", fmt.Errorf("not an ipv4 address: %v: %w", addr, ErrFormat)
+	}
+
+	return IPv4(addr.String()), nil
+}
+
+// Addr returns the address as a [netip.Addr].
+//
+// An IPv4-mapped IPv6 address such as "::ffff:192.0.2.1" is kept as such: use [netip.Addr.Unmap] to get the IPv4 address.
+func (u IPv6) Addr() (netip.Addr, error) {
+	addr, err := netip.ParseAddr(string(u))
+	if err != nil || !isIPv6(string(u)) {
+		return netip.Addr{}, fmt.Errorf("invalid ipv6 %q: %w", string(u), ErrFormat)
+	}
+
+	return addr, nil
+}
+
+// IPv6FromAddr returns the [IPv6] representation of an address.
+//
+// IPv4 addresses are rejected, as well as addresses with a zone, which are not valid [IPv6] values.
+func IPv6FromAddr(addr netip.Addr) (IPv6, error) {
+	if !addr.Is6() || addr.Zone() != "" {
+		return "", fmt.Errorf("not an ipv6 address without zone: %v: %w", addr, ErrFormat)
+	}
+
+	return IPv6(addr.String()), nil
+}
+
+// Prefix returns the CIDR notation as a [netip.Prefix].
+//
+// The address of the prefix is kept as written, e.g. "192.0.2.1/24": use [netip.Prefix.Masked] to get the network.
+func (u CIDR) Prefix() (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(string(u))
+	if err != nil || !isCIDR(string(u)) {
+		return netip.Prefix{}, fmt.Errorf("invalid cidr %q: %w", string(u), ErrFormat)
+	}
+
+	return prefix, nil
+}
+
+// CIDRFromPrefix returns the [CIDR] representation of a prefix.
+func CIDRFromPrefix(prefix netip.Prefix) (CIDR, error) {
+	if !prefix.IsValid() || prefix.Addr().Zone() != "" {
+		return "", fmt.Errorf("invalid prefix: %v: %w", prefix, ErrFormat)
+	}
+
+	return CIDR(prefix.String()), nil
+}
+
+// HardwareAddr returns the MAC address as a [net.HardwareAddr].
+func (u MAC) HardwareAddr() (net.HardwareAddr, error) {
+	hw, err := net.ParseMAC(string(u))
+	if err != nil {
+		return nil, fmt.Errorf("invalid mac %q: %w", string(u), ErrFormat)
+	}
+
+	return hw, nil
+}
+
+// MACFromHardwareAddr returns the [MAC] representation of a hardware address, e.g. "01:23:45:67:89:ab".
+func MACFromHardwareAddr(hw net.HardwareAddr) (MAC, error) {
+	mac := MAC(hw.String())
+	if !isMAC(string(mac)) {
+		return "", fmt.Errorf("invalid hardware address: %v: %w", []byte(hw), ErrFormat)
+	}
+
+	return mac, nil
+}
+
+// URL returns the URI as a [*url.URL].
+func (u URI) URL() (*url.URL, error) {
+	if !isRequestURI(string(u)) {
+		return nil, fmt.Errorf("invalid uri %q: %w", string(u), ErrFormat)
+	}
+	parsed, err := url.Parse(string(u))
+	if err != nil {
+		return nil, fmt.Errorf("invalid uri %q: %w", string(u), ErrFormat)
+	}
+
+	return parsed, nil
+}
+
+// URIFromURL returns the [URI] representation of a URL.
+//
+// Like other [URI] values, the URL must be absolute, or an absolute path.
+func URIFromURL(u *url.URL) (URI, error) {
+	if u == nil {
+		return "", fmt.Errorf("invalid nil url: %w", ErrFormat)
+	}
+	uri := URI(u.String())
+	if !isRequestURI(string(uri)) {
+		return "", fmt.Errorf("invalid uri %q: %w", string(uri), ErrFormat)
+	}
+
+	return uri, nil
+}
+
+// Address returns the email as a [*mail.Address], e.g. with Name "John Doe" for "John Doe <john@example.com>".
+func (e Email) Address() (*mail.Address, error) {
+	addr, err := mail.ParseAddress(string(e))
+	if err != nil || addr.Address == "" {
+		return nil, fmt.Errorf("invalid email %q: %w", string(e), ErrFormat)
+	}
+
+	return addr, nil
+}
+
+// EmailFromAddress returns the [Email] representation of an address, e.g. `"John Doe" <john@example.com>`,
+// or only the address when it has no name.
+func EmailFromAddress(addr *mail.Address) (Email, error) {
+	if addr == nil {
+		return "", fmt.Errorf("invalid nil email address: %w", ErrFormat)
+	}
+
+	email := Email(addr.Address)
+	if addr.Name != "" {
+		email = Email(addr.String())
+	}
+	if !IsEmail(string(email)) {
+		return "", fmt.Errorf("invalid email %q: %w", string(email), ErrFormat)
+	}
+
+	return email, nil
+}
+
+// ASCII returns the hostname in its ASCII form, suitable for DNS lookups,
+// e.g. "xn--bcher-kva.example" for "bücher.example".
+func (h Hostname) ASCII() (string, error) {
+	if !IsHostname(string(h)) {
+		return "", fmt.Errorf("invalid hostname %q: %w", string(h), ErrFormat)
+	}
+	ascii, err := idna.Lookup.ToASCII(string(h))
+	if err != nil {
+		return "", fmt.Errorf("invalid hostname %q: %w", string(h), ErrFormat)
+	}
+
+	return ascii, nil
+}
+
+// Bytes returns the 16 bytes of the UUID.
+func (u UUID) Bytes() ([16]byte, error) {
+	return uuidBytes(string(u), 0)
+}
+
+// Version returns the version of the UUID, e.g. 4 for a random UUID.
+func (u UUID) Version() (int, error) {
+	return uuidVersion(string(u))
+}
+
+// UUIDFromBytes returns the [UUID] representation of 16 bytes, in its canonical lower case form.
+func UUIDFromBytes(b [16]byte) UUID {
+	return UUID(uuid.UUID(b).String())
+}
+
+// Bytes returns the 16 bytes of the UUID.
+func (u UUID3) Bytes() ([16]byte, error) {
+	return uuidBytes(string(u), uuidV3)
+}
+
+// Version returns the version of the UUID, i.e. 3.
+func (u UUID3) Version() (int, error) {
+	return uuidCheckedVersion(string(u), uuidV3)
+}
+
+// UUID3FromBytes returns the [UUID3] representation of 16 bytes, in its canonical lower case form.
+func UUID3FromBytes(b [16]byte) (UUID3, error) {
+	s, err := uuidFromBytes(b, uuidV3)
+	return UUID3(s), err
+}
+
+// Bytes returns the 16 bytes of the UUID.
+func (u UUID4) Bytes() ([16]byte, error) {
+	return uuidBytes(string(u), uuidV4)
+}
+
+// Version returns the version of the UUID, i.e. 4.
+func (u UUID4) Version() (int, error) {
+	return uuidCheckedVersion(string(u), uuidV4)
+}
+
+// UUID4FromBytes returns the [UUID4] representation of 16 bytes, in its canonical lower case form.
+func UUID4FromBytes(b [16]byte) (UUID4, error) {
+	s, err := uuidFromBytes(b, uuidV4)
+	return UUID4(s), err
+}
+
+// Bytes returns the 16 bytes of the UUID.
+func (u UUID5) Bytes() ([16]byte, error) {
+	return uuidBytes(string(u), uuidV5)
+}
+
+// Version returns the version of the UUID, i.e. 5.
+func (u UUID5) Version() (int, error) {
+	return uuidCheckedVersion(string(u), uuidV5)
+}
+
+// UUID5FromBytes returns the [UUID5] representation of 16 bytes, in its canonical lower case form.
+func UUID5FromBytes(b [16]byte) (UUID5, error) {
+	s, err := uuidFromBytes(b, uuidV5)
+	return UUID5(s), err
+}
+
+// Bytes returns the 16 bytes of the UUID.
+func (u UUID7) Bytes() ([16]byte, error) {
+	return uuidBytes(string(u), uuidV7)
+}
+
+// Version returns the version of the UUID, i.e. 7.
+func (u UUID7) Version() (int, error) {
+	return uuidCheckedVersion(string(u), uuidV7)
+}
+
+// UUID7FromBytes returns the [UUID7] representation of 16 bytes, in its canonical lower case form.
+func UUID7FromBytes(b [16]byte) (UUID7, error) {
+	s, err := uuidFromBytes(b, uuidV7)
+	return UUID7(s), err
+}
+
+// uuidBytes parses a UUID, checking its version unless version is 0.
+func uuidBytes(s string, version int) ([16]byte, error) {
+	id, err := uuid.Parse(s)
+	if err != nil || (version != 0 && id.Version() != uuid.Version(version)) { //nolint:gosec // versions are small constants
+		return [16]byte{}, uuidError(s, version)
+	}
+
+	return id, nil
+}
+
+// uuidCheckedVersion returns the version of a UUID, which must be the expected one.
+func uuidCheckedVersion(s string, version int) (int, error) {
+	if _, err := uuidBytes(s, version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func uuidVersion(s string) (int, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return 0, uuidError(s, 0)
+	}
+
+	return int(id.Version()), nil
+}
+
+// uuidFromBytes renders a UUID, checking its version.
+func uuidFromBytes(b [16]byte, version int) (string, error) {
+	id := uuid.UUID(b)
+	if id.Version() != uuid.Version(version) { //nolint:gosec // versions are small constants
+		return "", fmt.Errorf("not a uuid%d: %s: %w", version, id, ErrFormat)
+	}
+
+	return id.String(), nil
+}
+
+func uuidError(s string, version int) error {
+	if version == 0 {
+		return fmt.Errorf("invalid uuid %q: %w", s, ErrFormat)
+	}
+
+	return fmt.Errorf("invalid uuid%d %q: %w", version, s, ErrFormat)
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestIPv4_Addr(t *testing.T) {
+	addr, err := IPv4("192.0.2.1").Addr()
+	require.NoError(t, err)
+	assert.EqualT(t, netip.MustParseAddr("192.0.2.1"), addr)
+
+	addr, err = IPv4("::ffff:192.0.2.1").Addr()
+	require.NoError(t, err)
+	assert.TrueT(t, addr.Is4())
+
+	for _, invalid := range []IPv4{"", "192.0.2", "192.0.2.256", "::1", "example.com"} {
+		_, err := invalid.Addr()
+		require.ErrorIsf(t, err, ErrFormat, "value: %q", invalid)
+	}
+
+	ip, err := IPv4FromAddr(netip.MustParseAddr("::ffff:192.0.2.1"))
+	require.NoError(t, err)
+	assert.EqualT(t, IPv4("192.0.2.1"), ip)
+
+	_, err = IPv4FromAddr(netip.MustParseAddr("2001:db8::1"))
+	require.ErrorIs(t, err, ErrFormat)
+	_, err = IPv4FromAddr(netip.Addr{})
+	require.ErrorIs(t, err, ErrFormat)
+}
+
+func TestIPv6_Addr(t *testing.T) {
+	addr, err := IPv6("2001:DB8::1").Addr()
+	require.NoError(t, err)
+	assert.EqualT(t, netip.MustParseAddr("2001:db8::1"), addr)
+
+	for _, invalid := range []IPv6{"", "192.0.2.1", "2001:db8::g", "fe80::1%eth0"} {
+		_, err := invalid.Addr()
+		require.ErrorIsf(t, err, ErrFormat, "value: %q", invalid)
+	}
+
+	ip, err := IPv6FromAddr(netip.MustParseAddr("2001:db8:0:0:0:0:0:1"))
+	require.NoError(t, err)
+	assert.EqualT(t, IPv6("2001:db8::1"), ip)
+	assert.TrueT(t, isIPv6(string(ip)))
+
+	for _, invalid := range []netip.Addr{netip.MustParseAddr("192.0.2.1"), netip.MustParseAddr("fe80::1%eth0"), {}} {
+		_, err := IPv6FromAddr(invalid)
+		require.ErrorIs(t, err, ErrFormat)
+	}
+}
+
+func TestCIDR_Prefix(t *testing.T) {
+	prefix, err := CIDR("192.0.2.1/24").Prefix()
+	require.NoError(t, err)
+	assert.EqualT(t, 24, prefix.Bits())
+	assert.EqualT(t, netip.MustParsePrefix("192.0.2.0/24"), prefix.Masked())
+
+	_, err = CIDR("192.0.2.1").Prefix()
+	require.ErrorIs(t, err, ErrFormat)
+
+	cidr, err := CIDRFromPrefix(netip.MustParsePrefix("2001:db8::/32"))
+	require.NoError(t, err)
+	assert.EqualT(t, CIDR("2001:db8::/32"), cidr)
+	assert.TrueT(t, isCIDR(string(cidr)))
+
+	_, err = CIDRFromPrefix(netip.Prefix{})
+	require.ErrorIs(t, err, ErrFormat)
+}
+
+func TestMAC_HardwareAddr(t *testing.T) {
+	hw, err := MAC("01-23-45-67-89-AB").HardwareAddr()
+	require.NoError(t, err)
+	assert.Equal(t, net.HardwareAddr{0x01, 0x23, 0x45, 0x67, 0x89, 0xab}, hw)
+
+	_, err = MAC("01:23:45").HardwareAddr()
+	require.ErrorIs(t, err, ErrFormat)
+
+	mac, err := MACFromHardwareAddr(hw)
+	require.NoError(t, err)
+	assert.EqualT(t, MAC("01:23:45:67:89:ab"), mac)
+
+	_, err = MACFromHardwareAddr(net.HardwareAddr{1, 2, 3})
+	require.ErrorIs(t, err, ErrFormat)
+}
+
+func TestURI_URL(t *testing.T) {
+	u, err := URI("https://example.com/path?q=1").URL()
+	require.NoError(t, err)
+	assert.EqualT(t, "example.com", u.Host)
+	assert.EqualT(t, "/path", u.Path)
+	assert.EqualT(t, "1", u.Query().Get("q"))
+
+	_, err = URI("relative/path").URL()
+	require.ErrorIs(t, err, ErrFormat)
+
+	uri, err := URIFromURL(&url.URL{Scheme: "https", Host: "example.com", Path: "/a b"})
+	require.NoError(t, err)
+	assert.EqualT(t, URI("https://example.com/a%20b"), uri)
+
+	_, err = URIFromURL(&url.URL{Path: "relative"})
+	require.ErrorIs(t, err, ErrFormat)
+	_, err = URIFromURL(nil)
+	require.ErrorIs(t, err, ErrFormat)
+}
+
+func TestEmail_Address(t *testing.T) {
+	addr, err := Email("John Doe <john@example.com>").Address()
+	require.NoError(t, err)
+	assert.EqualT(t, "John Doe", addr.Name)
+	assert.EqualT(t, "john@example.com", addr.Address)
+
+	_, err = Email("john").Address()
+	require.ErrorIs(t, err, ErrFormat)
+
+	email, err := EmailFromAddress(&mail.Address{Address: "john@example.com"})
+	require.NoError(t, err)
+	assert.EqualT(t, Email("john@example.com"), email)
+
+	email, err = EmailFromAddress(addr)
+	require.NoError(t, err)
+	assert.EqualT(t, Email(`"John Doe" <john@example.com>`), email)
+
+	_, err = EmailFromAddress(&mail.Address{Address: "john"})
+	require.ErrorIs(t, err, ErrFormat)
+	_, err = EmailFromAddress(nil)
+	require.ErrorIs(t, err, ErrFormat)
+}
+
+func TestHostname_ASCII(t *testing.T) {
+	ascii, err := Hostname("bücher.example").ASCII()
+	require.NoError(t, err)
+	assert.EqualT(t, "xn--bcher-kva.example", ascii)
+
+	ascii, err = Hostname("www.example.com").ASCII()
+	require.NoError(t, err)
+	assert.EqualT(t, "www.example.com", ascii)
+
+	_, err = Hostname("").ASCII()
+	require.ErrorIs(t, err, ErrFormat)
+}
+
+func TestUUID_Bytes(t *testing.T) {
+	const (
+		v4 = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+		v7 = "01890a5d-ac96-774b-bcce-b302099a8057"
+	)
+
+	b, err := UUID("F47AC10B-58CC-4372-A567-0E02B2C3D479").Bytes()
+	require.NoError(t, err)
+	assert.EqualT(t, byte(0xf4), b[0])
+	assert.EqualT(t, byte(0x79), b[15])
+	assert.EqualT(t, UUID(v4), UUIDFromBytes(b))
+
+	version, err := UUID(v7).Version()
+	require.NoError(t, err)
+	assert.EqualT(t, 7, version)
+
+	_, err = UUID("not-a-uuid").Version()
+	require.ErrorIs(t, err, ErrFormat)
+	_, err = UUID("not-a-uuid").Bytes()
+	require.ErrorIs(t, err, ErrFormat)
+
+	t.Run("with versioned types", func(t *testing.T) {
+		version, err := UUID4(v4).Version()
+		require.NoError(t, err)
+		assert.EqualT(t, 4, version)
+
+		b, err := UUID4(v4).Bytes()
+		require.NoError(t, err)
+		id, err := UUID4FromBytes(b)
+		require.NoError(t, err)
+		assert.EqualT(t, UUID4(v4), id)
+
+		version, err = UUID7(v4).Version()
+		require.ErrorIs(t, err, ErrFormat)
+		assert.EqualT(t, 0, version)
+		_, err = UUID3(v4).Bytes()
+		require.ErrorIs(t, err, ErrFormat)
+		_, err = UUID5FromBytes(b)
+		require.ErrorIs(t, err, ErrFormat)
+
+		b, err = UUID7(v7).Bytes()
+		require.NoError(t, err)
+		id7, err := UUID7FromBytes(b)
+		require.NoError(t, err)
+		assert.EqualT(t, UUID7(v7), id7)
+		_, err = UUID3FromBytes(b)
+		require.ErrorIs(t, err, ErrFormat)
+	})
+}
@@ -39,10 +39,9 @@ func NewObjectId(hex string) ObjectId { //nolint:revive
 }
 
 // MarshalText turns this instance into text.
+//
+// Like [ObjectId.MarshalJSON], the nil [ObjectId] renders as 24 zeros.
 func (id ObjectId) MarshalText() ([]byte, error) {
-	if id == nilObjectID {
-		return nil, nil
-	}
 	return []byte(id.Hex()), nil
 }
 
@@ -68,8 +67,10 @@ func (id *ObjectId) Scan(raw any) error {
 		data = v
 	case string:
 		data = []byte(v)
+	case nil:
+		// NULL yields the nil ObjectId
 	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.URI from: %#v: %w", v, ErrFormat)
+		return fmt.Errorf("cannot sql.Scan() strfmt.ObjectId from: %#v: %w", v, ErrFormat)
 	}
 
 	return id.UnmarshalText(data)
@@ -96,16 +97,14 @@ func (id ObjectId) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON sets the [ObjectId] from JSON.
 func (id *ObjectId) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
 	var hexStr string
 	if err := json.Unmarshal(data, &hexStr); err != nil {
 		return err
 	}
-	oid, err := objectIDFromHex(hexStr)
-	if err != nil {
-		return err
-	}
-	*id = oid
-	return nil
+	return id.UnmarshalText([]byte(hexStr))
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
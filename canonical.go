@@ -0,0 +1,454 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"net/netip"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/idna"
+)
+
+// Canonical forms.
+//
+// Formats based on a string are not validated when unmarshaled: their Canonical method validates the value
+// and returns an error wrapping [ErrFormat] when it is invalid.
+// Other formats are parsed when unmarshaled: their Canonical method cannot fail.
+//
+// Two values which are equal in meaning have the same canonical form.
+
+// Canonical returns the URI with a lower case scheme and host, e.g. "http://example.com/Path" for "HTTP://Example.COM/Path".
+func (u URI) Canonical() (URI, error) {
+	parsed, err := u.URL()
+	if err != nil {
+		return "", err
+	}
+	parsed.Host = strings.ToLower(parsed.Host)
+
+	return URI(parsed.String()), nil
+}
+
+// Canonical returns the address of the email with a lower case domain, e.g. "John.Doe@example.com"
+// for "John Doe <John.Doe@Example.COM>".
+//
+// The display name is dropped. The local part is case sensitive and is kept as is.
+func (e Email) Canonical() (Email, error) {
+	addr, err := e.Address()
+	if err != nil {
+		return "", err
+	}
+	at := strings.LastIndexByte(addr.Address, '@')
+	if at < 0 {
+		return "", fmt.Errorf("invalid email %q: %w", string(e), ErrFormat)
+	}
+
+	return Email(addr.Address[:at+1] + strings.ToLower(addr.Address[at+1:])), nil
+}
+
+// Canonical returns the hostname in lower case ASCII, without a trailing dot,
+// e.g. "xn--bcher-kva.example" for "Bücher.example.".
+//
+// A hostname which is an IP address is rendered like [IPv4.Canonical] or [IPv6.Canonical].
+func (h Hostname) Canonical() (Hostname, error) {
+	if !IsHostname(string(h)) {
+		return "", fmt.Errorf("invalid hostname %q: %w", string(h), ErrFormat)
+	}
+	name := strings.TrimSuffix(string(h), ".")
+	if addr, err := netip.ParseAddr(name); err == nil {
+		return Hostname(addr.Unmap().String()), nil
+	}
+
+	ascii, err := idna.Lookup.ToASCII(name)
+	if err != nil {
+		return "", fmt.Errorf("invalid hostname %q: %w", string(h), ErrFormat)
+	}
+
+	return Hostname(strings.ToLower(ascii)), nil
+}
+
+// Canonical returns the address in dotted decimal notation, e.g. "192.0.2.1" for "::ffff:192.0.2.1".
+func (u IPv4) Canonical() (IPv4, error) {
+	addr, err := u.Addr()
+	if err != nil {
+		return "", err
+	}
+
+	return IPv4(addr.String()), nil
+}
+
+// Canonical returns the address in the RFC 5952 notation, i.e. compressed and in lower case,
+// e.g. "2001:db8::1" for "2001:0DB8:0:0:0:0:0:1".
+func (u IPv6) Canonical() (IPv6, error) {
+	addr, err := u.Addr()
+	if err != nil {
+		return "", err
+	}
+
+	return IPv6(addr.String()), nil
+}
+
+// Canonical returns the network of the CIDR, with its address rendered like [IPv4.Canonical] or [IPv6.Canonical].
+//
+// The bits of the address beyond the prefix length are cleared, e.g. "192.0.2.1/24" becomes "192.0.2.0/24",
+// so that equal CIDRs (see [CIDR.Equal]) have the same canonical form.
+func (u CIDR) Canonical() (CIDR, error) {
+	prefix, err := u.Prefix()
+	if err != nil {
+		return "", err
+	}
+
+	return CIDR(prefix.Masked().String()), nil
+}
+
+// Canonical returns the MAC address in lower case, colon-separated, e.g. "01:23:45:67:89:ab" for "0123.4567.89AB".
+func (u MAC) Canonical() (MAC, error) {
+	hw, err := u.HardwareAddr()
+	if err != nil {
+		return "", err
+	}
+
+	return MAC(hw.String()), nil
+}
+
+// Canonical returns the UUID in lower case, with dashes, e.g. "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+// for "F47AC10B58CC4372A5670E02B2C3D479" or "urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479".
+func (u UUID) Canonical() (UUID, error) {
+	b, err := u.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return UUIDFromBytes(b), nil
+}
+
+// Canonical returns the UUID in lower case, with dashes.
+func (u UUID3) Canonical() (UUID3, error) {
+	b, err := u.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return UUID3(uuid.UUID(b).String()), nil
+}
+
+// Canonical returns the UUID in lower case, with dashes.
+func (u UUID4) Canonical() (UUID4, error) {
+	b, err := u.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return UUID4(uuid.UUID(b).String()), nil
+}
+
+// Canonical returns the UUID in lower case, with dashes.
+func (u UUID5) Canonical() (UUID5, error) {
+	b, err := u.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return UUID5(uuid.UUID(b).String()), nil
+}
+
+// Canonical returns the UUID in lower case, with dashes.
+func (u UUID7) Canonical() (UUID7, error) {
+	b, err := u.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return UUID7(uuid.UUID(b).String()), nil
+}
+
+// Canonical returns the ISBN without hyphens nor spaces, e.g. "9780306406157" for "978-0-306-40615-7".
+//
+// An ISBN-10 is not converted to an ISBN-13.
+func (u ISBN) Canonical() (ISBN, error) {
+	if !isISBN10(string(u)) && !isISBN13(string(u)) {
+		return "", fmt.Errorf("invalid isbn %q: %w", string(u), ErrFormat)
+	}
+
+	return ISBN(stripWhiteSpacesAndMinus(string(u))), nil
+}
+
+// Canonical returns the ISBN without hyphens nor spaces, e.g. "0306406152" for "0-306-40615-2".
+func (u ISBN10) Canonical() (ISBN10, error) {
+	if !isISBN10(string(u)) {
+		return "", fmt.Errorf("invalid isbn10 %q: %w", string(u), ErrFormat)
+	}
+
+	return ISBN10(stripWhiteSpacesAndMinus(string(u))), nil
+}
+
+// Canonical returns the ISBN without hyphens nor spaces, e.g. "9780306406157" for "978-0-306-40615-7".
+func (u ISBN13) Canonical() (ISBN13, error) {
+	if !isISBN13(string(u)) {
+		return "", fmt.Errorf("invalid isbn13 %q: %w", string(u), ErrFormat)
+	}
+
+	return ISBN13(stripWhiteSpacesAndMinus(string(u))), nil
+}
+
+// Canonical returns the card number without hyphens nor spaces, e.g. "4111111111111111" for "4111 1111 1111 1111".
+func (u CreditCard) Canonical() (CreditCard, error) {
+	if !isCreditCard(string(u)) {
+		return "", fmt.Errorf("invalid credit card number: %w", ErrFormat) // the number is not echoed
+	}
+
+	return CreditCard(stripWhiteSpacesAndMinus(string(u))), nil
+}
+
+// Canonical returns the SSN with hyphens, e.g. "123-45-6789" for "123 45 6789".
+func (u SSN) Canonical() (SSN, error) {
+	if !isSSN(string(u)) {
+		return "", fmt.Errorf("invalid ssn: %w", ErrFormat) // the number is not echoed
+	}
+	digits := stripWhiteSpacesAndMinus(string(u))
+
+	return SSN(digits[:3] + "-" + digits[3:5] + "-" + digits[5:]), nil
+}
+
+// Canonical returns the color as a '#' followed by 6 lower case hexadecimal digits, e.g. "#aabbcc" for "ABC".
+func (h HexColor) Canonical() (HexColor, error) {
+	if !isHexcolor(string(h)) {
+		return "", fmt.Errorf("invalid hexcolor %q: %w", string(h), ErrFormat)
+	}
+	digits := strings.ToLower(strings.TrimPrefix(string(h), "#"))
+	if len(digits) == len("abc") {
+		digits = string([]byte{digits[0], digits[0], digits[1], digits[1], digits[2], digits[2]})
+	}
+
+	return HexColor("#" + digits), nil
+}
+
+// Canonical returns the color in the CSS serialized form, e.g. "rgb(1, 2, 3)" for "rgb( 1,2 ,3 )".
+func (r RGBColor) Canonical() (RGBColor, error) {
+	matches := rxRGBcolor.FindStringSubmatch(string(r))
+	if matches == nil {
+		return "", fmt.Errorf("invalid rgbcolor %q: %w", string(r), ErrFormat)
+	}
+
+	return RGBColor("rgb(" + strings.Join(matches[1:], ", ") + ")"), nil
+}
+
+// Canonical returns the password unchanged.
+func (p Password) Canonical() (Password, error) {
+	return p, nil
+}
+
+// Canonical returns the cron expression with fields separated by a single space and names in upper case,
+// e.g. "0 9 * * MON-FRI" for "0  9 * * mon-fri", or the predefined schedule in lower case, e.g. "@daily".
+func (c Cron) Canonical() (Cron, error) {
+	if _, err := c.Schedule(); err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(string(c), "@") {
+		return Cron(strings.ToLower(string(c))), nil
+	}
+
+	return Cron(strings.ToUpper(strings.Join(strings.Fields(string(c)), " "))), nil
+}
+
+// Canonical returns the time zone unchanged, or a fixed offset in the form "UTC+02:00",
+// e.g. for "GMT+02" or "UTC+0200".
+//
+// Links of the time zone database, e.g. "US/Eastern", are not resolved.
+func (z TimeZone) Canonical() (TimeZone, error) {
+	if _, err := ParseTimeZone(string(z)); err != nil {
+		return "", err
+	}
+	offset, isOffset := parseTimeZoneOffset(string(z))
+	if !isOffset {
+		return z, nil
+	}
+
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	minutes := offset / secondsPerMinute
+
+	return TimeZone(fmt.Sprintf("UTC%c%02d:%02d", sign, minutes/minutesPerHour, minutes%minutesPerHour)), nil
+}
+
+// Canonical returns the date-time in UTC.
+func (t DateTime) Canonical() DateTime {
+	return DateTime(time.Time(t).UTC())
+}
+
+// Canonical returns the date at midnight UTC.
+func (d Date) Canonical() Date {
+	t := time.Time(d)
+	return Date(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+// Canonical returns the date-time in UTC, rendered with [time.RFC3339Nano].
+func (t ExactDateTime) Canonical() ExactDateTime {
+	return NewExactDateTime(t.time.UTC())
+}
+
+// Canonical returns the date in UTC.
+func (d HTTPDate) Canonical() HTTPDate {
+	return HTTPDate(time.Time(d).UTC())
+}
+
+// Canonical returns the interval expressed with its start and end in UTC, e.g. "2024-01-01T00:00:00Z/2024-02-01T00:00:00Z"
+// for "2024-01-01T00:00:00Z/P1M".
+func (i Interval) Canonical() Interval {
+	return NewInterval(DateTime(i.start.UTC()), DateTime(i.end.UTC()))
+}
+
+// Canonical returns the repeating interval unchanged: its duration is significant for the repetitions.
+func (r RepeatingInterval) Canonical() RepeatingInterval {
+	return r
+}
+
+// Canonical returns the date-time unchanged: its offset and time zone are significant.
+func (z ZonedDateTime) Canonical() ZonedDateTime {
+	return z
+}
+
+// Canonical returns the duration unchanged.
+func (d Duration) Canonical() Duration {
+	return d
+}
+
+// Canonical returns the date unchanged.
+func (d CivilDate) Canonical() CivilDate {
+	return d
+}
+
+// Canonical returns the year and month unchanged.
+func (ym YearMonth) Canonical() YearMonth {
+	return ym
+}
+
+// Canonical returns the year unchanged.
+func (y Year) Canonical() Year {
+	return y
+}
+
+// Canonical returns the recurrence rule unchanged: it is always rendered canonically.
+func (r RRule) Canonical() RRule {
+	return r
+}
+
+// Canonical returns the ULID unchanged.
+func (u ULID) Canonical() ULID {
+	return u
+}
+
+// Canonical returns the ObjectId unchanged.
+func (id ObjectId) Canonical() ObjectId { //nolint:revive
+	return id
+}
+
+// Canonical returns the bytes unchanged.
+func (b Base64) Canonical() Base64 {
+	return b
+}
+
+func stripWhiteSpacesAndMinus(str string) string {
+	return whiteSpacesAndMinus.ReplaceAllString(str, "")
+}
+
+// Canonicalize returns the canonical form of a value of the named format.
+//
+// The value is parsed like with [Registry.Parse] and validated like with [Registry.Validates],
+// then rendered in the canonical form of its type, e.g. "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+// for "uuid" "F47AC10B58CC4372A5670E02B2C3D479".
+//
+// Values of types without a Canonical method are rendered with their String method.
+func (f *defaultFormats) Canonicalize(name, data string) (string, error) {
+	v, err := f.Parse(name, data)
+	if err != nil {
+		return "", err
+	}
+	if !f.Validates(name, data) {
+		return "", fmt.Errorf("invalid %s %q: %w", name, data, ErrFormat)
+	}
+
+	if canonical, ok := canonicalizers[reflect.TypeOf(v)]; ok {
+		return canonical(v)
+	}
+	if c, ok := v.(interface{ canonicalText() (string, error) }); ok {
+		return c.canonicalText()
+	}
+	if s, ok := v.(fmt.Stringer); ok {
+		return s.String(), nil
+	}
+
+	return data, nil
+}
+
+// canonicalizers renders the canonical form of the values returned by [Registry.Parse], which are pointers.
+//
+//nolint:gochecknoglobals // immutable lookup table
+var canonicalizers = map[reflect.Type]func(any) (string, error){
+	reflect.TypeFor[*URI]():               canonicalOf[URI],
+	reflect.TypeFor[*Email]():             canonicalOf[Email],
+	reflect.TypeFor[*Hostname]():          canonicalOf[Hostname],
+	reflect.TypeFor[*IPv4]():              canonicalOf[IPv4],
+	reflect.TypeFor[*IPv6]():              canonicalOf[IPv6],
+	reflect.TypeFor[*CIDR]():              canonicalOf[CIDR],
+	reflect.TypeFor[*MAC]():               canonicalOf[MAC],
+	reflect.TypeFor[*UUID]():              canonicalOf[UUID],
+	reflect.TypeFor[*UUID3]():             canonicalOf[UUID3],
+	reflect.TypeFor[*UUID4]():             canonicalOf[UUID4],
+	reflect.TypeFor[*UUID5]():             canonicalOf[UUID5],
+	reflect.TypeFor[*UUID7]():             canonicalOf[UUID7],
+	reflect.TypeFor[*ISBN]():              canonicalOf[ISBN],
+	reflect.TypeFor[*ISBN10]():            canonicalOf[ISBN10],
+	reflect.TypeFor[*ISBN13]():            canonicalOf[ISBN13],
+	reflect.TypeFor[*CreditCard]():        canonicalOf[CreditCard],
+	reflect.TypeFor[*SSN]():               canonicalOf[SSN],
+	reflect.TypeFor[*HexColor]():          canonicalOf[HexColor],
+	reflect.TypeFor[*RGBColor]():          canonicalOf[RGBColor],
+	reflect.TypeFor[*Password]():          canonicalOf[Password],
+	reflect.TypeFor[*Cron]():              canonicalOf[Cron],
+	reflect.TypeFor[*TimeZone]():          canonicalOf[TimeZone],
+	reflect.TypeFor[*DateTime]():          canonicalValueOf[DateTime],
+	reflect.TypeFor[*Date]():              canonicalValueOf[Date],
+	reflect.TypeFor[*ExactDateTime]():     canonicalValueOf[ExactDateTime],
+	reflect.TypeFor[*HTTPDate]():          canonicalValueOf[HTTPDate],
+	reflect.TypeFor[*Interval]():          canonicalValueOf[Interval],
+	reflect.TypeFor[*RepeatingInterval](): canonicalValueOf[RepeatingInterval],
+	reflect.TypeFor[*ZonedDateTime]():     canonicalValueOf[ZonedDateTime],
+	reflect.TypeFor[*Duration]():          canonicalValueOf[Duration],
+	reflect.TypeFor[*CivilDate]():         canonicalValueOf[CivilDate],
+	reflect.TypeFor[*YearMonth]():         canonicalValueOf[YearMonth],
+	reflect.TypeFor[*Year]():              canonicalValueOf[Year],
+	reflect.TypeFor[*RRule]():             canonicalValueOf[RRule],
+	reflect.TypeFor[*ULID]():              canonicalValueOf[ULID],
+	reflect.TypeFor[*ObjectId]():          canonicalValueOf[ObjectId],
+	reflect.TypeFor[*Base64]():            canonicalValueOf[Base64],
+}
+
+type canonicalFormat[T any] interface {
+	Canonical() (T, error)
+	String() string
+}
+
+type canonicalValue[T any] interface {
+	Canonical() T
+	String() string
+}
+
+func canonicalOf[T canonicalFormat[T]](v any) (string, error) {
+	c, err := (*v.(*T)).Canonical() //nolint:forcetypeassert // the table is keyed by type
+	if err != nil {
+		return "", err
+	}
+
+	return c.String(), nil
+}
+
+func canonicalValueOf[T canonicalValue[T]](v any) (string, error) {
+	return (*v.(*T)).Canonical().String(), nil //nolint:forcetypeassert // the table is keyed by type
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestCanonicalize(t *testing.T) {
+	withFixedOffsets := func(t *testing.T) {
+		t.Helper()
+		old := TimeZoneFixedOffsets
+		t.Cleanup(func() { TimeZoneFixedOffsets = old })
+		TimeZoneFixedOffsets = true
+	}
+	withFixedOffsets(t)
+
+	for _, tc := range []struct {
+		name     string
+		inputs   []string
+		expected string
+	}{
+		{"uri", []string{"HTTP://Example.COM/Path?q=A", "http://example.com/Path?q=A"}, "http://example.com/Path?q=A"},
+		{"email", []string{"John.Doe@Example.COM", "John Doe <John.Doe@example.com>"}, "John.Doe@example.com"},
+		{"hostname", []string{"Bücher.Example.", "xn--bcher-kva.example", "XN--BCHER-KVA.EXAMPLE"}, "xn--bcher-kva.example"},
+		{"hostname", []string{"192.0.2.1.", "192.0.2.1"}, "192.0.2.1"},
+		{"ipv4", []string{"192.0.2.1", "::ffff:192.0.2.1"}, "192.0.2.1"},
+		{"ipv6", []string{"2001:0DB8:0:0:0:0:0:1", "2001:db8::0:1"}, "2001:db8::1"},
+		{"cidr", []string{"2001:0DB8::/32", "2001:db8:a0b:12f0::1/32"}, "2001:db8::/32"},
+		{"cidr", []string{"192.0.2.1/24", "192.0.2.0/24"}, "192.0.2.0/24"},
+		{"mac", []string{"01:23:45:67:89:AB", "01-23-45-67-89-ab", "0123.4567.89ab"}, "01:23:45:67:89:ab"},
+		{"uuid", []string{
+			"F47AC10B-58CC-4372-A567-0E02B2C3D479",
+			"f47ac10b58cc4372a5670e02b2c3d479",
+			"urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			"{f47ac10b-58cc-4372-a567-0e02b2c3d479}",
+		}, "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{"uuid4", []string{"F47AC10B58CC4372A5670E02B2C3D479"}, "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{"uuid7", []string{"01890A5D-AC96-774B-BCCE-B302099A8057"}, "01890a5d-ac96-774b-bcce-b302099a8057"},
+		{"isbn", []string{"978-0-306-40615-7", "978 0 306 40615 7"}, "9780306406157"},
+		{"isbn10", []string{"0-306-40615-2"}, "0306406152"},
+		{"isbn13", []string{"978-0-306-40615-7"}, "9780306406157"},
+		{"creditcard", []string{"4111 1111 1111 1111", "4111-1111-1111-1111"}, "4111111111111111"},
+		{"ssn", []string{"123 45 6789", "123-45-6789"}, "123-45-6789"},
+		{"hexcolor", []string{"ABC", "#aabbcc", "#AaBbCc"}, "#aabbcc"},
+		{"rgbcolor", []string{"rgb( 1,2 ,3 )", "rgb(1, 2, 3)"}, "rgb(1, 2, 3)"},
+		{"password", []string{" Secret "}, " Secret "},
+		{"cron", []string{"0  9 * * mon-fri", "0 9 * * MON-FRI"}, "0 9 * * MON-FRI"},
+		{"cron", []string{"@DAILY"}, "@daily"},
+		{"timezone", []string{"Europe/Paris"}, "Europe/Paris"},
+		{"timezone", []string{"GMT+02", "UTC+0200", "UTC+02:00"}, "UTC+02:00"},
+		{"timezone", []string{"UTC-0530"}, "UTC-05:30"},
+		{"date-time", []string{"2024-05-01T12:00:00+02:00", "2024-05-01T10:00:00.000Z"}, "2024-05-01T10:00:00.000Z"},
+		{"date", []string{"2024-05-01"}, "2024-05-01"},
+		{"duration", []string{"90m", "1h30m"}, "1h30m0s"},
+		{"interval", []string{"2024-01-01T00:00:00Z/P1M", "2024-01-01T01:00:00+01:00/2024-02-01T00:00:00Z"}, "2024-01-01T00:00:00.000Z/2024-02-01T00:00:00.000Z"},
+		{"byte", []string{"aGVsbG8="}, "aGVsbG8="},
+	} {
+		t.Run(tc.name+" "+tc.expected, func(t *testing.T) {
+			for _, input := range tc.inputs {
+				canonical, err := Default.Canonicalize(tc.name, input)
+				require.NoErrorf(t, err, "input: %q", input)
+				assert.EqualTf(t, tc.expected, canonical, "input: %q", input)
+
+				again, err := Default.Canonicalize(tc.name, canonical)
+				require.NoError(t, err)
+				assert.EqualTf(t, canonical, again, "canonical forms should be stable")
+			}
+		})
+	}
+
+	t.Run("should reject invalid values", func(t *testing.T) {
+		for _, tc := range []struct {
+			name  string
+			input string
+		}{
+			{"uri", "relative"},
+			{"email", "john"},
+			{"hostname", ""},
+			{"ipv4", "::1"},
+			{"ipv6", "192.0.2.1"},
+			{"cidr", "192.0.2.1"},
+			{"mac", "01:23"},
+			{"uuid", "not-a-uuid"},
+			{"uuid3", "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+			{"isbn", "978-0-306-40615-8"},
+			{"creditcard", "4111 1111 1111 1112"},
+			{"ssn", "123456789"},
+			{"hexcolor", "#abcd"},
+			{"rgbcolor", "rgb(256, 0, 0)"},
+			{"cron", "* * *"},
+			{"timezone", "Mars/Olympus"},
+			{"date-time", "yesterday"},
+		} {
+			_, err := Default.Canonicalize(tc.name, tc.input)
+			require.ErrorIsf(t, err, ErrFormat, "%s: %q", tc.name, tc.input)
+		}
+
+		_, err := Default.Canonicalize("unknown", "value")
+		require.Error(t, err)
+	})
+
+	t.Run("should use the settings of the registry", func(t *testing.T) {
+		units := NewDurationUnits()
+		require.NoError(t, units.Add(8*time.Hour, "shift", "shifts"))
+		registry := NewFormats(WithDurationUnits(units))
+
+		canonical, err := registry.Canonicalize("duration", "2 shifts")
+		require.NoError(t, err)
+		assert.EqualT(t, "16h0m0s", canonical)
+	})
+
+	t.Run("should validate and stringify custom formats", func(t *testing.T) {
+		registry := NewFormats()
+		pw := Password("")
+		registry.Add("pin", &pw, func(s string) bool { return len(s) == 4 })
+
+		canonical, err := registry.Canonicalize("pin", "1234")
+		require.NoError(t, err)
+		assert.EqualT(t, "1234", canonical)
+
+		_, err = registry.Canonicalize("pin", "12345")
+		require.ErrorIs(t, err, ErrFormat)
+	})
+}
+
+func TestCanonical_Values(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	dt := DateTime(time.Date(2024, 5, 1, 12, 0, 0, 0, paris))
+	assert.EqualT(t, time.UTC, time.Time(dt.Canonical()).Location())
+	assert.TrueT(t, time.Time(dt).Equal(time.Time(dt.Canonical())))
+
+	d := Date(time.Date(2024, 5, 1, 23, 30, 0, 0, paris))
+	assert.EqualT(t, Date(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)), d.Canonical())
+
+	exact, err := ParseExactDateTime("2024-05-01 12:00:00,50+02:00")
+	require.NoError(t, err)
+	assert.EqualT(t, "2024-05-01T10:00:00.5Z", exact.Canonical().String())
+
+	password, err := Password("secret").Canonical()
+	require.NoError(t, err)
+	assert.EqualT(t, Password("secret"), password)
+}
@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	monthsPerYear = 12
+	secondsPerDay = 24 * 60 * 60
+)
+
+// CivilDate represents a calendar date (year, month and day), e.g. "2024-05-01".
+//
+// Unlike [Date], a [CivilDate] does not depend on any time zone: two equal calendar days are always equal,
+// and converting a [CivilDate] never shifts it by one day. Values read from a [time.Time] (e.g. by
+// [CivilDate.Scan]) keep the calendar day as seen in the location of that [time.Time].
+//
+// [CivilDate] is not registered in the [Default] registry: "date" is handled by [Date].
+// Use [Date.Civil] and [CivilDate.Date] to convert between the two.
+//
+// The zero value is not a valid calendar date: see [CivilDate.IsZero].
+type CivilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// NewCivilDate builds a [CivilDate].
+//
+// Like [time.Date], values outside of their usual range are normalized, e.g. October 32 is November 1.
+func NewCivilDate(year int, month time.Month, day int) CivilDate {
+	return civilDateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// CivilDateOf returns the [CivilDate] of a [DateTime], as seen in the location of that [DateTime].
+func CivilDateOf(t DateTime) CivilDate {
+	return civilDateOf(time.Time(t))
+}
+
+// civilDateOf returns the calendar day of t, as seen in the location of t.
+func civilDateOf(t time.Time) CivilDate {
+	year, month, day := t.Date()
+
+	return CivilDate{year: year, month: month, day: day}
+}
+
+// ParseCivilDate parses a string that represents a date, like [ParseDate] does.
+func ParseCivilDate(data string) (CivilDate, error) {
+	d, err := ParseDate(data)
+	if err != nil {
+		return CivilDate{}, err
+	}
+
+	return d.Civil(), nil
+}
+
+// Civil returns the calendar day of the [Date], as seen in the location of the [Date].
+func (d Date) Civil() CivilDate {
+	return civilDateOf(time.Time(d))
+}
+
+// Date returns the [CivilDate] as a [Date], i.e. midnight in [DefaultTimeLocation].
+func (d CivilDate) Date() Date {
+	return Date(d.time(DefaultTimeLocation))
+}
+
+// In returns the first instant of the day in the given location.
+func (d CivilDate) In(loc *time.Location) DateTime {
+	return DateTime(d.time(loc))
+}
+
+func (d CivilDate) time(loc *time.Location) time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, loc)
+}
+
+// Year returns the year.
+func (d CivilDate) Year() int {
+	return d.year
+}
+
+// Month returns the month.
+func (d CivilDate) Month() time.Month {
+	return d.month
+}
+
+// Day returns the day of the month.
+func (d CivilDate) Day() int {
+	return d.day
+}
+
+// YearMonth returns the month of this date.
+func (d CivilDate) YearMonth() YearMonth {
+	return YearMonth{year: d.year, month: d.month}
+}
+
+// Weekday returns the day of the week.
+func (d CivilDate) Weekday() time.Weekday {
+	return d.time(time.UTC).Weekday()
+}
+
+// YearDay returns the day of the year, in the range [1,365] for common years and [1,366] for leap years.
+func (d CivilDate) YearDay() int {
+	return d.time(time.UTC).YearDay()
+}
+
+// AddDays returns the date n days later (or earlier, when n is negative).
+func (d CivilDate) AddDays(n int) CivilDate {
+	return NewCivilDate(d.year, d.month, d.day+n)
+}
+
+// AddMonths returns the date n months later (or earlier, when n is negative).
+//
+// Unlike [time.Time.AddDate], the day is clamped to the last day of the resulting month:
+// January 31 plus one month is February 28 (or 29), not March 2 (or 3).
+func (d CivilDate) AddMonths(n int) CivilDate {
+	ym := NewYearMonth(d.year, d.month).AddMonths(n)
+
+	return CivilDate{year: ym.year, month: ym.month, day: min(d.day, ym.Days())}
+}
+
+// AddYears returns the date n years later (or earlier, when n is negative).
+//
+// Like [CivilDate.AddMonths], February 29 is clamped to February 28 on common years.
+func (d CivilDate) AddYears(n int) CivilDate {
+	return d.AddMonths(n * monthsPerYear)
+}
+
+// DaysSince returns the number of days from u to d, which is negative when d is before u.
+func (d CivilDate) DaysSince(u CivilDate) int {
+	return int(d.epochDays() - u.epochDays())
+}
+
+// epochDays returns the number of days since January 1, 1970.
+func (d CivilDate) epochDays() int64 {
+	// midnight UTC is always a whole number of days since the epoch
+	return d.time(time.UTC).Unix() / secondsPerDay
+}
+
+// StartOfMonth returns the first day of the month.
+func (d CivilDate) StartOfMonth() CivilDate {
+	return CivilDate{year: d.year, month: d.month, day: 1}
+}
+
+// EndOfMonth returns the last day of the month.
+func (d CivilDate) EndOfMonth() CivilDate {
+	return CivilDate{year: d.year, month: d.month, day: d.YearMonth().Days()}
+}
+
+// Before tells if d is before u.
+func (d CivilDate) Before(u CivilDate) bool {
+	return d.Compare(u) < 0
+}
+
+// After tells if d is after u.
+func (d CivilDate) After(u CivilDate) bool {
+	return d.Compare(u) > 0
+}
+
+// Compare returns -1 if d is before u, +1 if d is after u and 0 if they are the same day.
+func (d CivilDate) Compare(u CivilDate) int {
+	switch {
+	case d.year != u.year:
+		return cmp.Compare(d.year, u.year)
+	case d.month != u.month:
+		return cmp.Compare(d.month, u.month)
+	default:
+		return cmp.Compare(d.day, u.day)
+	}
+}
+
+// IsZero returns whether the date is a zero value.
+func (d CivilDate) IsZero() bool {
+	return d == CivilDate{}
+}
+
+// Equal checks if two [CivilDate] instances are the same day.
+func (d CivilDate) Equal(other CivilDate) bool {
+	return d == other
+}
+
+// String converts this date to a string, e.g. "2024-05-01".
+//
+// The zero [CivilDate] renders as an empty string.
+func (d CivilDate) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%04d-%02d-%02d", d.year, d.month, d.day)
+}
+
+// MarshalText serializes this date to string.
+func (d CivilDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses a text representation into a date.
+func (d *CivilDate) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = CivilDate{}
+		return nil
+	}
+	v, err := ParseCivilDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Scan scans a [CivilDate] value from database driver type.
+//
+// A [time.Time] yields its calendar day as seen in its own location: the time of day is dropped.
+func (d *CivilDate) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return d.UnmarshalText(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case time.Time:
+		*d = civilDateOf(v)
+		return nil
+	case nil:
+		*d = CivilDate{}
+		return nil
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.CivilDate from: %#v: %w", v, ErrFormat)
+	}
+}
+
+// Value converts [CivilDate] to a primitive value ready to written to a database.
+func (d CivilDate) Value() (driver.Value, error) {
+	return driver.Value(d.String()), nil
+}
+
+// MarshalJSON returns the [CivilDate] as JSON.
+func (d CivilDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON sets the [CivilDate] from JSON.
+func (d *CivilDate) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(str))
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+//
+// The date is encoded as text, which is independent of any time zone.
+func (d CivilDate) MarshalBinary() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+func (d *CivilDate) UnmarshalBinary(data []byte) error {
+	return d.UnmarshalText(data)
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (d CivilDate) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (d *CivilDate) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (d *CivilDate) DeepCopyInto(out *CivilDate) {
+	*out = *d
+}
+
+// DeepCopy copies the receiver into a new [CivilDate].
+func (d *CivilDate) DeepCopy() *CivilDate {
+	if d == nil {
+		return nil
+	}
+	out := new(CivilDate)
+	d.DeepCopyInto(out)
+	return out
+}
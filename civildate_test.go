@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+var (
+	_ sql.Scanner   = &CivilDate{}
+	_ driver.Valuer = CivilDate{}
+)
+
+func TestCivilDate(t *testing.T) {
+	t.Run("should normalize", func(t *testing.T) {
+		d := NewCivilDate(2024, time.February, 30)
+		assert.EqualT(t, "2024-03-01", d.String())
+		assert.EqualT(t, 2024, d.Year())
+		assert.EqualT(t, time.March, d.Month())
+		assert.EqualT(t, 1, d.Day())
+	})
+
+	t.Run("should parse", func(t *testing.T) {
+		d, err := ParseCivilDate("2024-05-01")
+		require.NoError(t, err)
+		assert.EqualT(t, NewCivilDate(2024, time.May, 1), d)
+
+		_, err = ParseCivilDate("2024-02-30")
+		require.Error(t, err)
+	})
+
+	t.Run("should not depend on the time zone", func(t *testing.T) {
+		tokyo := time.FixedZone("JST", 9*60*60)
+		newYork := time.FixedZone("EST", -5*60*60)
+
+		a := CivilDateOf(DateTime(time.Date(2024, time.May, 1, 1, 0, 0, 0, tokyo)))
+		b := CivilDateOf(DateTime(time.Date(2024, time.May, 1, 23, 0, 0, 0, newYork)))
+		assert.TrueT(t, a.Equal(b))
+		assert.EqualT(t, a, b)
+
+		start := a.In(tokyo)
+		assert.EqualT(t, time.Date(2024, time.May, 1, 0, 0, 0, 0, tokyo), time.Time(start))
+		assert.EqualT(t, a, CivilDateOf(start))
+	})
+
+	t.Run("should convert from and to Date", func(t *testing.T) {
+		d := NewCivilDate(2024, time.May, 1)
+		assert.EqualT(t, Date(time.Date(2024, time.May, 1, 0, 0, 0, 0, DefaultTimeLocation)), d.Date())
+		assert.EqualT(t, d, d.Date().Civil())
+	})
+
+	t.Run("should compare", func(t *testing.T) {
+		a := NewCivilDate(2024, time.May, 1)
+		b := NewCivilDate(2024, time.May, 2)
+		assert.TrueT(t, a.Before(b))
+		assert.TrueT(t, b.After(a))
+		assert.EqualT(t, -1, a.Compare(b))
+		assert.EqualT(t, 0, a.Compare(a))
+		assert.EqualT(t, 1, NewCivilDate(2025, time.January, 1).Compare(b))
+		assert.TrueT(t, CivilDate{}.IsZero())
+		assert.FalseT(t, a.IsZero())
+	})
+}
+
+func TestCivilDate_Arithmetic(t *testing.T) {
+	d := NewCivilDate(2024, time.January, 31)
+
+	assert.EqualT(t, time.Wednesday, d.Weekday())
+	assert.EqualT(t, 31, d.YearDay())
+	assert.EqualT(t, NewYearMonth(2024, time.January), d.YearMonth())
+
+	assert.EqualT(t, "2024-02-01", d.AddDays(1).String())
+	assert.EqualT(t, "2023-12-31", d.AddDays(-31).String())
+	assert.EqualT(t, "2024-02-29", d.AddMonths(1).String())
+	assert.EqualT(t, "2024-03-31", d.AddMonths(2).String())
+	assert.EqualT(t, "2023-11-30", d.AddMonths(-2).String())
+	assert.EqualT(t, "2025-02-28", NewCivilDate(2024, time.February, 29).AddYears(1).String())
+
+	assert.EqualT(t, 366, NewCivilDate(2025, time.January, 1).DaysSince(NewCivilDate(2024, time.January, 1)))
+	assert.EqualT(t, -1, NewCivilDate(1969, time.December, 31).DaysSince(NewCivilDate(1970, time.January, 1)))
+	assert.EqualT(t, 0, d.DaysSince(d))
+
+	assert.EqualT(t, "2024-02-01", NewCivilDate(2024, time.February, 15).StartOfMonth().String())
+	assert.EqualT(t, "2024-02-29", NewCivilDate(2024, time.February, 15).EndOfMonth().String())
+	assert.EqualT(t, "2023-02-28", NewCivilDate(2023, time.February, 15).EndOfMonth().String())
+}
+
+func TestCivilDate_Serialization(t *testing.T) {
+	d := NewCivilDate(2024, time.May, 1)
+
+	t.Run("with JSON", func(t *testing.T) {
+		b, err := d.MarshalJSON()
+		require.NoError(t, err)
+		assert.EqualT(t, `"2024-05-01"`, string(b))
+
+		var out CivilDate
+		require.NoError(t, out.UnmarshalJSON(b))
+		assert.EqualT(t, d, out)
+
+		require.NoError(t, out.UnmarshalJSON([]byte(jsonNull)))
+		assert.EqualT(t, d, out)
+
+		require.Error(t, out.UnmarshalJSON([]byte(`"2024-13-01"`)))
+
+		b, err = CivilDate{}.MarshalJSON()
+		require.NoError(t, err)
+		assert.EqualT(t, `""`, string(b))
+		require.NoError(t, out.UnmarshalJSON(b))
+		assert.TrueT(t, out.IsZero(), "the zero value should round-trip")
+	})
+
+	t.Run("with Scan and Value", func(t *testing.T) {
+		tokyo := time.FixedZone("JST", 9*60*60)
+		for _, value := range []any{"2024-05-01", []byte("2024-05-01"), time.Date(2024, time.May, 1, 8, 30, 0, 0, tokyo)} {
+			var out CivilDate
+			require.NoError(t, out.Scan(value))
+			assert.EqualT(t, d, out)
+		}
+
+		var out CivilDate
+		require.NoError(t, out.Scan(nil))
+		assert.TrueT(t, out.IsZero())
+		require.ErrorIs(t, out.Scan(20240501), ErrFormat)
+
+		v, err := d.Value()
+		require.NoError(t, err)
+		assert.EqualValues(t, "2024-05-01", v)
+	})
+
+	t.Run("with gob", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&b).Encode(d))
+
+		var out CivilDate
+		require.NoError(t, gob.NewDecoder(&b).Decode(&out))
+		assert.EqualT(t, d, out)
+	})
+
+	t.Run("with DeepCopy", func(t *testing.T) {
+		out := d.DeepCopy()
+		assert.EqualT(t, d, *out)
+
+		var inNil *CivilDate
+		assert.Nil(t, inNil.DeepCopy())
+	})
+}
@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// declaration describes the format to generate.
+type declaration struct {
+	// Type is the name of the Go type, e.g. "ZipCode".
+	Type string
+
+	// Validator is the name of the validation function, e.g. "isZipCode".
+	Validator string
+
+	// Name is the name of the format in the registry, e.g. "zipcode".
+	Name string
+
+	// Swagger is the name of the format in the swagger:strfmt annotation.
+	Swagger string
+
+	// Example is a valid value, used by the round-trip test.
+	Example string
+
+	// Package is the name of the package of the generated code.
+	Package string
+}
+
+var errDeclaration = errors.New("invalid declaration")
+
+// normalize checks the declaration and sets its defaults.
+func (d *declaration) normalize() error {
+	switch {
+	case !token.IsIdentifier(d.Type) || !token.IsExported(d.Type):
+		return fmt.Errorf("the type %q must be an exported identifier: %w", d.Type, errDeclaration)
+	case d.Validator == "":
+		return fmt.Errorf("a validator is required: %w", errDeclaration)
+	case !token.IsIdentifier(d.Package):
+		return fmt.Errorf("the package %q must be an identifier (set -package, or run with go generate): %w", d.Package, errDeclaration)
+	}
+
+	if d.Name == "" {
+		d.Name = strings.ToLower(d.Type)
+	}
+	if d.Swagger == "" {
+		d.Swagger = d.Name
+	}
+	if strings.ContainsAny(d.Name+d.Swagger, " \t\r\n\"`") {
+		return fmt.Errorf("the format names %q and %q must not contain spaces nor quotes: %w", d.Name, d.Swagger, errDeclaration)
+	}
+
+	return nil
+}
+
+// Spec is the name of the unexported type describing the format, e.g. "zipCodeFormat".
+func (d declaration) Spec() string {
+	return unexported(d.Type) + "Format"
+}
+
+// Receiver is the name of the receiver of the methods, e.g. "z".
+func (d declaration) Receiver() string {
+	return strings.ToLower(d.Type[:1])
+}
+
+// unexported lower-cases the leading upper-case letters of an identifier, like Go initialisms are written:
+// "ZipCode" becomes "zipCode", "SSN" becomes "ssn" and "URLPath" becomes "urlPath".
+func unexported(name string) string {
+	runes := []rune(name)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
+
+// generate renders the code and the round-trip test of a format. The test is nil without an example.
+func generate(d declaration) (code, test []byte, err error) {
+	if err := d.normalize(); err != nil {
+		return nil, nil, err
+	}
+
+	code, err = render(codeTemplate, d)
+	if err != nil {
+		return nil, nil, err
+	}
+	if d.Example == "" {
+		return code, nil, nil
+	}
+
+	test, err = render(testTemplate, d)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return code, test, nil
+}
+
+func render(tpl *template.Template, d declaration) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, d); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+var codeTemplate = template.Must(template.New("code").Parse(`// Code generated by strfmtgen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"database/sql/driver"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// {{ .Type }} represents the {{ .Name }} string format.
+//
+// swagger:strfmt {{ .Swagger }}.
+type {{ .Type }} string
+
+// {{ .Spec }} describes the [{{ .Type }}] format.
+type {{ .Spec }} struct{}
+
+func ({{ .Spec }}) FormatName() string { return "{{ .Name }}" }
+
+func ({{ .Spec }}) IsValid(s string) bool { return {{ .Validator }}(s) }
+
+func init() { //nolint:gochecknoinits // registers the {{ .Name }} format in the default registry
+	{{ .Receiver }} := {{ .Type }}("")
+	strfmt.Default.Add("{{ .Name }}", &{{ .Receiver }}, {{ .Spec }}{}.IsValid)
+}
+
+{{ with $r := .Receiver }}{{ with $t := $.Type }}{{ with $f := printf "strfmt.StringFormat[%s]" $.Spec -}}
+// MarshalText turns this instance into text.
+func ({{ $r }} {{ $t }}) MarshalText() ([]byte, error) {
+	return {{ $f }}({{ $r }}).MarshalText()
+}
+
+// UnmarshalText hydrates this instance from text.
+func ({{ $r }} *{{ $t }}) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*{{ $f }})({{ $r }}).UnmarshalText(data)
+}
+
+// Scan read a value from a database driver.
+func ({{ $r }} *{{ $t }}) Scan(raw any) error {
+	return (*{{ $f }})({{ $r }}).Scan(raw)
+}
+
+// Value converts a value to a database driver value.
+func ({{ $r }} {{ $t }}) Value() (driver.Value, error) {
+	return {{ $f }}({{ $r }}).Value()
+}
+
+func ({{ $r }} {{ $t }}) String() string {
+	return string({{ $r }})
+}
+
+// MarshalJSON returns the [{{ $t }}] as JSON.
+func ({{ $r }} {{ $t }}) MarshalJSON() ([]byte, error) {
+	return {{ $f }}({{ $r }}).MarshalJSON()
+}
+
+// UnmarshalJSON sets the [{{ $t }}] from JSON.
+func ({{ $r }} *{{ $t }}) UnmarshalJSON(data []byte) error {
+	return (*{{ $f }})({{ $r }}).UnmarshalJSON(data)
+}
+
+// MarshalBSON document from this value.
+func ({{ $r }} {{ $t }}) MarshalBSON() ([]byte, error) {
+	return {{ $f }}({{ $r }}).MarshalBSON()
+}
+
+// UnmarshalBSON document into this value.
+func ({{ $r }} *{{ $t }}) UnmarshalBSON(data []byte) error {
+	return (*{{ $f }})({{ $r }}).UnmarshalBSON(data)
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func ({{ $r }} *{{ $t }}) DeepCopyInto(out *{{ $t }}) {
+	(*{{ $f }})({{ $r }}).DeepCopyInto((*{{ $f }})(out))
+}
+
+// DeepCopy copies the receiver into a new [{{ $t }}].
+func ({{ $r }} *{{ $t }}) DeepCopy() *{{ $t }} {
+	return (*{{ $t }})((*{{ $f }})({{ $r }}).DeepCopy())
+}
+
+// Validate checks that the value is a valid [{{ $t }}].
+func ({{ $r }} {{ $t }}) Validate() error {
+	return {{ $f }}({{ $r }}).Validate()
+}
+
+// Canonical returns the [{{ $t }}] after checking that it is valid.
+func ({{ $r }} {{ $t }}) Canonical() ({{ $t }}, error) {
+	canonical, err := {{ $f }}({{ $r }}).Canonical()
+
+	return {{ $t }}(canonical), err
+}
+
+// Equal checks if two [{{ $t }}] instances are equal.
+func ({{ $r }} {{ $t }}) Equal(other {{ $t }}) bool {
+	return {{ $f }}({{ $r }}).Equal({{ $f }}(other))
+}
+
+// IsZero returns true when the [{{ $t }}] is empty.
+func ({{ $r }} {{ $t }}) IsZero() bool {
+	return {{ $r }} == ""
+}
+{{ end }}{{ end }}{{ end }}`))
+
+var testTemplate = template.Must(template.New("test").Parse(`// Code generated by strfmtgen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func Test{{ .Type }}_RoundTrip(t *testing.T) {
+	const example = {{ printf "%q" .Example }}
+	value := {{ .Type }}(example)
+
+	if err := value.Validate(); err != nil {
+		t.Fatalf("the example should be valid: %v", err)
+	}
+	if !strfmt.Default.Validates("{{ .Name }}", example) {
+		t.Fatal("the format should be registered")
+	}
+
+	text, err := value.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromText {{ .Type }}
+	if err := fromText.UnmarshalText(text); err != nil || fromText != value {
+		t.Errorf("text round trip: got %q, %v", fromText, err)
+	}
+
+	js, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON {{ .Type }}
+	if err := json.Unmarshal(js, &fromJSON); err != nil || fromJSON != value {
+		t.Errorf("JSON round trip: got %q, %v", fromJSON, err)
+	}
+
+	sqlValue, err := value.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromSQL {{ .Type }}
+	if err := fromSQL.Scan(sqlValue); err != nil || fromSQL != value {
+		t.Errorf("SQL round trip: got %q, %v", fromSQL, err)
+	}
+
+	doc, err := value.MarshalBSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromBSON {{ .Type }}
+	if err := fromBSON.UnmarshalBSON(doc); err != nil || fromBSON != value {
+		t.Errorf("BSON round trip: got %q, %v", fromBSON, err)
+	}
+
+	if copied := value.DeepCopy(); *copied != value {
+		t.Errorf("deep copy: got %q", *copied)
+	}
+}
+`))
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("should match the generated example", func(t *testing.T) {
+		code, test, err := generate(declaration{
+			Type:      "ZipCode",
+			Name:      "zipcode",
+			Swagger:   "zip-code",
+			Validator: "isZipCode",
+			Example:   "12345",
+			Package:   "example",
+		})
+		require.NoError(t, err)
+
+		expected, err := os.ReadFile(filepath.Join("internal", "example", "zipcode_strfmt.go"))
+		require.NoError(t, err)
+		assert.EqualT(t, string(expected), string(code), "run go generate ./... to update the example")
+
+		expected, err = os.ReadFile(filepath.Join("internal", "example", "zipcode_strfmt_test.go"))
+		require.NoError(t, err)
+		assert.EqualT(t, string(expected), string(test), "run go generate ./... to update the example")
+	})
+
+	t.Run("should set defaults", func(t *testing.T) {
+		code, test, err := generate(declaration{Type: "SKU", Validator: "pkg.IsSKU", Package: "models"})
+		require.NoError(t, err)
+		assert.Nil(t, test)
+		assert.Contains(t, string(code), "swagger:strfmt sku.")
+		assert.Contains(t, string(code), `strfmt.Default.Add("sku", &s, skuFormat{}.IsValid)`)
+		assert.Contains(t, string(code), "return pkg.IsSKU(s)")
+	})
+
+	t.Run("should reject invalid declarations", func(t *testing.T) {
+		for _, d := range []declaration{
+			{Type: "", Validator: "v", Package: "p"},
+			{Type: "zipCode", Validator: "v", Package: "p"},
+			{Type: "Zip Code", Validator: "v", Package: "p"},
+			{Type: "ZipCode", Package: "p"},
+			{Type: "ZipCode", Validator: "v"},
+			{Type: "ZipCode", Validator: "v", Package: "p", Name: `zip"code`},
+		} {
+			_, _, err := generate(d)
+			require.ErrorIsf(t, err, errDeclaration, "declaration: %+v", d)
+		}
+	})
+}
+
+func TestUnexported(t *testing.T) {
+	for input, expected := range map[string]string{
+		"ZipCode": "zipCode",
+		"SSN":     "ssn",
+		"URLPath": "urlPath",
+		"X":       "x",
+	} {
+		assert.EqualTf(t, expected, unexported(input), "input: %q", input)
+	}
+}
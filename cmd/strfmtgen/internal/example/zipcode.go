@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+// Package example holds a format generated by strfmtgen.
+//
+// The generated files are checked against the output of strfmtgen by its tests.
+package example
+
+//go:generate go run github.com/go-openapi/strfmt/cmd/strfmtgen -type ZipCode -name zipcode -swagger zip-code -validator isZipCode -example 12345
+
+// isZipCode checks for a 5-digit US ZIP code.
+func isZipCode(s string) bool {
+	if len(s) != 5 {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+// Code generated by strfmtgen. DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql/driver"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// ZipCode represents the zipcode string format.
+//
+// swagger:strfmt zip-code.
+type ZipCode string
+
+// zipCodeFormat describes the [ZipCode] format.
+type zipCodeFormat struct{}
+
+func (zipCodeFormat) FormatName() string { return "zipcode" }
+
+func (zipCodeFormat) IsValid(s string) bool { return isZipCode(s) }
+
+func init() { //nolint:gochecknoinits // registers the zipcode format in the default registry
+	z := ZipCode("")
+	strfmt.Default.Add("zipcode", &z, zipCodeFormat{}.IsValid)
+}
+
+// MarshalText turns this instance into text.
+func (z ZipCode) MarshalText() ([]byte, error) {
+	return strfmt.StringFormat[zipCodeFormat](z).MarshalText()
+}
+
+// UnmarshalText hydrates this instance from text.
+func (z *ZipCode) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*strfmt.StringFormat[zipCodeFormat])(z).UnmarshalText(data)
+}
+
+// Scan read a value from a database driver.
+func (z *ZipCode) Scan(raw any) error {
+	return (*strfmt.StringFormat[zipCodeFormat])(z).Scan(raw)
+}
+
+// Value converts a value to a database driver value.
+func (z ZipCode) Value() (driver.Value, error) {
+	return strfmt.StringFormat[zipCodeFormat](z).Value()
+}
+
+func (z ZipCode) String() string {
+	return string(z)
+}
+
+// MarshalJSON returns the [ZipCode] as JSON.
+func (z ZipCode) MarshalJSON() ([]byte, error) {
+	return strfmt.StringFormat[zipCodeFormat](z).MarshalJSON()
+}
+
+// UnmarshalJSON sets the [ZipCode] from JSON.
+func (z *ZipCode) UnmarshalJSON(data []byte) error {
+	return (*strfmt.StringFormat[zipCodeFormat])(z).UnmarshalJSON(data)
+}
+
+// MarshalBSON document from this value.
+func (z ZipCode) MarshalBSON() ([]byte, error) {
+	return strfmt.StringFormat[zipCodeFormat](z).MarshalBSON()
+}
+
+// UnmarshalBSON document into this value.
+func (z *ZipCode) UnmarshalBSON(data []byte) error {
+	return (*strfmt.StringFormat[zipCodeFormat])(z).UnmarshalBSON(data)
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (z *ZipCode) DeepCopyInto(out *ZipCode) {
+	(*strfmt.StringFormat[zipCodeFormat])(z).DeepCopyInto((*strfmt.StringFormat[zipCodeFormat])(out))
+}
+
+// DeepCopy copies the receiver into a new [ZipCode].
+func (z *ZipCode) DeepCopy() *ZipCode {
+	return (*ZipCode)((*strfmt.StringFormat[zipCodeFormat])(z).DeepCopy())
+}
+
+// Validate checks that the value is a valid [ZipCode].
+func (z ZipCode) Validate() error {
+	return strfmt.StringFormat[zipCodeFormat](z).Validate()
+}
+
+// Canonical returns the [ZipCode] after checking that it is valid.
+func (z ZipCode) Canonical() (ZipCode, error) {
+	canonical, err := strfmt.StringFormat[zipCodeFormat](z).Canonical()
+
+	return ZipCode(canonical), err
+}
+
+// Equal checks if two [ZipCode] instances are equal.
+func (z ZipCode) Equal(other ZipCode) bool {
+	return strfmt.StringFormat[zipCodeFormat](z).Equal(strfmt.StringFormat[zipCodeFormat](other))
+}
+
+// IsZero returns true when the [ZipCode] is empty.
+func (z ZipCode) IsZero() bool {
+	return z == ""
+}
@@ -0,0 +1,62 @@
+// Code generated by strfmtgen. DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestZipCode_RoundTrip(t *testing.T) {
+	const example = "12345"
+	value := ZipCode(example)
+
+	if err := value.Validate(); err != nil {
+		t.Fatalf("the example should be valid: %v", err)
+	}
+	if !strfmt.Default.Validates("zipcode", example) {
+		t.Fatal("the format should be registered")
+	}
+
+	text, err := value.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromText ZipCode
+	if err := fromText.UnmarshalText(text); err != nil || fromText != value {
+		t.Errorf("text round trip: got %q, %v", fromText, err)
+	}
+
+	js, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON ZipCode
+	if err := json.Unmarshal(js, &fromJSON); err != nil || fromJSON != value {
+		t.Errorf("JSON round trip: got %q, %v", fromJSON, err)
+	}
+
+	sqlValue, err := value.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromSQL ZipCode
+	if err := fromSQL.Scan(sqlValue); err != nil || fromSQL != value {
+		t.Errorf("SQL round trip: got %q, %v", fromSQL, err)
+	}
+
+	doc, err := value.MarshalBSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromBSON ZipCode
+	if err := fromBSON.UnmarshalBSON(doc); err != nil || fromBSON != value {
+		t.Errorf("BSON round trip: got %q, %v", fromBSON, err)
+	}
+
+	if copied := value.DeepCopy(); *copied != value {
+		t.Errorf("deep copy: got %q", *copied)
+	}
+}
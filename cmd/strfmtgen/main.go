@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+// strfmtgen generates a string format type with all the methods of the formats of the strfmt package,
+// its registration in strfmt.Default and a round-trip test.
+//
+// The generated type is implemented on top of strfmt.StringFormat. It is meant to be used with go generate:
+//
+//	//go:generate go run github.com/go-openapi/strfmt/cmd/strfmtgen -type ZipCode -name zipcode -validator isZipCode -example 12345
+//
+// This writes zipcode_strfmt.go and zipcode_strfmt_test.go in the directory of the package.
+//
+// Usage:
+//
+//	strfmtgen -type <Go type> -validator <func(string) bool> [-name <format>] [-swagger <name>] [-example <value>]
+//
+// Flags:
+//
+//	-type       the name of the Go type to generate, e.g. ZipCode
+//	-validator  the name of the function validating values, with signature func(string) bool
+//	-name       the name of the format in the registry, defaulting to the lower-cased type name
+//	-swagger    the name of the format in the swagger:strfmt annotation, defaulting to the format name
+//	-example    a valid value, used by the round-trip test; no test is generated when empty
+//	-package    the package name, defaulting to $GOPACKAGE
+//	-output     the output file, defaulting to <lower-cased type>_strfmt.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("strfmtgen: ")
+
+	var (
+		decl   declaration
+		output string
+	)
+	flag.StringVar(&decl.Type, "type", "", "the name of the Go type to generate")
+	flag.StringVar(&decl.Validator, "validator", "", "the name of the function validating values")
+	flag.StringVar(&decl.Name, "name", "", "the name of the format in the registry (default: lower-cased type)")
+	flag.StringVar(&decl.Swagger, "swagger", "", "the name of the format for swagger:strfmt (default: format name)")
+	flag.StringVar(&decl.Example, "example", "", "a valid value used by the round-trip test (default: no test)")
+	flag.StringVar(&decl.Package, "package", os.Getenv("GOPACKAGE"), "the package name")
+	flag.StringVar(&output, "output", "", "the output file (default: <lower-cased type>_strfmt.go)")
+	flag.Parse()
+
+	code, test, err := generate(decl)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if output == "" {
+		output = strings.ToLower(decl.Type) + "_strfmt.go"
+	}
+	if err := os.WriteFile(output, code, 0o600); err != nil {
+		log.Fatal(err)
+	}
+	if test == nil {
+		return
+	}
+
+	testOutput := strings.TrimSuffix(output, ".go") + "_test.go"
+	if err := os.WriteFile(testOutput, test, 0o600); err != nil {
+		log.Fatal(fmt.Errorf("writing the test: %w", err))
+	}
+}
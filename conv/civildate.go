@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// CivilDate returns a pointer to of the [strfmt.CivilDate] value passed in.
+func CivilDate(v strfmt.CivilDate) *strfmt.CivilDate {
+	return &v
+}
+
+// CivilDateValue returns the value of the [strfmt.CivilDate] pointer passed in or
+// the default value if the pointer is nil.
+func CivilDateValue(v *strfmt.CivilDate) strfmt.CivilDate {
+	if v == nil {
+		return strfmt.CivilDate{}
+	}
+
+	return *v
+}
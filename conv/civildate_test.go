@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestCivilDateValue(t *testing.T) {
+	assert.EqualT(t, strfmt.CivilDate{}, CivilDateValue(nil))
+	d := strfmt.NewCivilDate(2024, time.May, 1)
+	assert.EqualT(t, d, CivilDateValue(CivilDate(d)))
+}
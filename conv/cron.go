@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// Cron returns a pointer to of the [strfmt.Cron] value passed in.
+func Cron(v strfmt.Cron) *strfmt.Cron {
+	return &v
+}
+
+// CronValue returns the value of the [strfmt.Cron] pointer passed in or
+// the default value if the pointer is nil.
+func CronValue(v *strfmt.Cron) strfmt.Cron {
+	if v == nil {
+		return strfmt.Cron("")
+	}
+
+	return *v
+}
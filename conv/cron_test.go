@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestCronValue(t *testing.T) {
+	assert.EqualT(t, strfmt.Cron(""), CronValue(nil))
+	value := strfmt.Cron("@daily")
+	assert.EqualT(t, value, CronValue(Cron(value)))
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// ExactDateTime returns a pointer to of the [strfmt.ExactDateTime] value passed in.
+func ExactDateTime(v strfmt.ExactDateTime) *strfmt.ExactDateTime {
+	return &v
+}
+
+// ExactDateTimeValue returns the value of the [strfmt.ExactDateTime] pointer passed in or
+// the default value if the pointer is nil.
+func ExactDateTimeValue(v *strfmt.ExactDateTime) strfmt.ExactDateTime {
+	if v == nil {
+		return strfmt.ExactDateTime{}
+	}
+
+	return *v
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// HTTPDate returns a pointer to of the [strfmt.HTTPDate] value passed in.
+func HTTPDate(v strfmt.HTTPDate) *strfmt.HTTPDate {
+	return &v
+}
+
+// HTTPDateValue returns the value of the [strfmt.HTTPDate] pointer passed in or
+// the default value if the pointer is nil.
+func HTTPDateValue(v *strfmt.HTTPDate) strfmt.HTTPDate {
+	if v == nil {
+		return strfmt.HTTPDate{}
+	}
+
+	return *v
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestHTTPDateValue(t *testing.T) {
+	assert.EqualT(t, strfmt.HTTPDate{}, HTTPDateValue(nil))
+	value := strfmt.HTTPDateOf(strfmt.DateTime(time.Now()))
+	assert.EqualT(t, value, HTTPDateValue(HTTPDate(value)))
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// Interval returns a pointer to of the [strfmt.Interval] value passed in.
+func Interval(v strfmt.Interval) *strfmt.Interval {
+	return &v
+}
+
+// IntervalValue returns the value of the [strfmt.Interval] pointer passed in or
+// the default value if the pointer is nil.
+func IntervalValue(v *strfmt.Interval) strfmt.Interval {
+	if v == nil {
+		return strfmt.Interval{}
+	}
+
+	return *v
+}
+
+// RepeatingInterval returns a pointer to of the [strfmt.RepeatingInterval] value passed in.
+func RepeatingInterval(v strfmt.RepeatingInterval) *strfmt.RepeatingInterval {
+	return &v
+}
+
+// RepeatingIntervalValue returns the value of the [strfmt.RepeatingInterval] pointer passed in or
+// the default value if the pointer is nil.
+func RepeatingIntervalValue(v *strfmt.RepeatingInterval) strfmt.RepeatingInterval {
+	if v == nil {
+		return strfmt.RepeatingInterval{}
+	}
+
+	return *v
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestIntervalValue(t *testing.T) {
+	assert.Equal(t, strfmt.Interval{}, IntervalValue(nil))
+	value, err := strfmt.ParseInterval("2024-01-01/P1M")
+	require.NoError(t, err)
+	assert.Equal(t, value, IntervalValue(&value))
+	assert.Equal(t, &value, Interval(value))
+}
+
+func TestRepeatingIntervalValue(t *testing.T) {
+	assert.Equal(t, strfmt.RepeatingInterval{}, RepeatingIntervalValue(nil))
+	value, err := strfmt.ParseRepeatingInterval("R2/2024-01-01/P1M")
+	require.NoError(t, err)
+	assert.Equal(t, value, RepeatingIntervalValue(&value))
+	assert.Equal(t, &value, RepeatingInterval(value))
+}
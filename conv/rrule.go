@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// RRule returns a pointer to of the [strfmt.RRule] value passed in.
+func RRule(v strfmt.RRule) *strfmt.RRule {
+	return &v
+}
+
+// RRuleValue returns the value of the [strfmt.RRule] pointer passed in or
+// the default value if the pointer is nil.
+func RRuleValue(v *strfmt.RRule) strfmt.RRule {
+	if v == nil {
+		return strfmt.RRule{}
+	}
+
+	return *v
+}
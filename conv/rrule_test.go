@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestRRuleValue(t *testing.T) {
+	assert.TrueT(t, RRuleValue(nil).IsZero())
+	r, err := strfmt.ParseRRule("FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10")
+	require.NoError(t, err)
+	assert.Equal(t, r, RRuleValue(RRule(r)))
+}
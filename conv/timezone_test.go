@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import (
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestTimeZoneValue(t *testing.T) {
+	assert.EqualT(t, strfmt.TimeZone(""), TimeZoneValue(nil))
+	value := strfmt.TimeZone("Europe/Paris")
+	assert.EqualT(t, value, TimeZoneValue(TimeZone(value)))
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestYearMonthValue(t *testing.T) {
+	assert.EqualT(t, strfmt.YearMonth{}, YearMonthValue(nil))
+	value := strfmt.NewYearMonth(2024, time.May)
+	assert.EqualT(t, value, YearMonthValue(&value))
+	assert.Equal(t, &value, YearMonth(value))
+}
+
+func TestYearValue(t *testing.T) {
+	assert.EqualT(t, strfmt.Year(0), YearValue(nil))
+	value := strfmt.Year(2024)
+	assert.EqualT(t, value, YearValue(&value))
+	assert.Equal(t, &value, Year(value))
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// ZonedDateTime returns a pointer to of the [strfmt.ZonedDateTime] value passed in.
+func ZonedDateTime(v strfmt.ZonedDateTime) *strfmt.ZonedDateTime {
+	return &v
+}
+
+// ZonedDateTimeValue returns the value of the [strfmt.ZonedDateTime] pointer passed in or
+// the default value if the pointer is nil.
+func ZonedDateTimeValue(v *strfmt.ZonedDateTime) strfmt.ZonedDateTime {
+	if v == nil {
+		return strfmt.ZonedDateTime{}
+	}
+
+	return *v
+}
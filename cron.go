@@ -0,0 +1,476 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() { //nolint:gochecknoinits // registers cron format in the default registry
+	c := Cron("")
+	Default.Add("cron", &c, cronFormat{}.IsValid)
+}
+
+const (
+	cronFields            = 5
+	cronFieldsWithSeconds = 6
+
+	// cronSearchYears bounds the search for the next fire time: a schedule on February 29 may not fire for 8 years.
+	cronSearchYears = 9
+)
+
+// cronDescriptors maps the predefined schedules to their 5-field equivalent.
+//
+//nolint:gochecknoglobals // immutable lookup table
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField describes the range and the names accepted by a field of a cron expression.
+type cronField struct {
+	name       string
+	min, max   int
+	names      []string // names of the values, starting at min
+	noSpecific bool     // accepts "?" for "no specific value"
+}
+
+//nolint:gochecknoglobals // immutable field definitions
+var (
+	cronSecond     = cronField{name: "second", min: 0, max: 59}
+	cronMinute     = cronField{name: "minute", min: 0, max: 59}
+	cronHour       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day of month", min: 1, max: 31, noSpecific: true}
+	cronMonth      = cronField{
+		name: "month", min: 1, max: 12,
+		names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"},
+	}
+	// 7 is an alias for Sunday.
+	cronDayOfWeek = cronField{
+		name: "day of week", min: 0, max: 7,
+		names:      []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+		noSpecific: true,
+	}
+)
+
+// IsCron returns true when the string is a valid cron expression.
+//
+// See [ParseCron] for the accepted syntax.
+func IsCron(str string) bool {
+	_, err := ParseCron(str)
+	return err == nil
+}
+
+// ParseCron parses a cron expression into a [CronSchedule].
+//
+// The following forms are accepted:
+//
+//   - the standard 5 fields: "minute hour day-of-month month day-of-week", e.g. "*/15 9-17 * * MON-FRI"
+//   - 6 fields, with seconds first: "second minute hour day-of-month month day-of-week", e.g. "30 0 0 * * *"
+//   - the predefined schedules @yearly (or @annually), @monthly, @weekly, @daily (or @midnight) and @hourly
+//
+// Each field is a comma-separated list of values, ranges ("1-5") or "*", optionally followed by a step ("*/10", "0-30/5").
+// Months and days of week may be given by their 3-letter English names, in any case. Sunday is 0 or 7.
+// "?" is accepted as "*" in the day-of-month and day-of-week fields.
+//
+// Like the standard cron, when both day-of-month and day-of-week are restricted, a day matches when either field matches.
+//
+// Schedules which can never fire (e.g. "0 0 30 2 *") are rejected.
+func ParseCron(data string) (CronSchedule, error) {
+	expr := data
+	if strings.HasPrefix(expr, "@") {
+		var found bool
+		if expr, found = cronDescriptors[strings.ToLower(expr)]; !found {
+			return CronSchedule{}, fmt.Errorf("invalid cron expression %q: unknown descriptor: %w", data, ErrFormat)
+		}
+	}
+
+	fields := strings.Fields(expr)
+	var s CronSchedule
+	switch len(fields) {
+	case cronFields:
+		s.seconds = 1 // at second 0
+	case cronFieldsWithSeconds:
+		s.withSeconds = true
+		var err error
+		if s.seconds, _, err = cronSecond.parse(fields[0]); err != nil {
+			return CronSchedule{}, cronError(data, err)
+		}
+		fields = fields[1:]
+	default:
+		return CronSchedule{}, fmt.Errorf("invalid cron expression %q: expected 5 or 6 fields: %w", data, ErrFormat)
+	}
+
+	var err error
+	if s.minutes, _, err = cronMinute.parse(fields[0]); err != nil {
+		return CronSchedule{}, cronError(data, err)
+	}
+	if s.hours, _, err = cronHour.parse(fields[1]); err != nil {
+		return CronSchedule{}, cronError(data, err)
+	}
+	if s.daysOfMonth, s.anyDayOfMonth, err = cronDayOfMonth.parse(fields[2]); err != nil {
+		return CronSchedule{}, cronError(data, err)
+	}
+	if s.months, _, err = cronMonth.parse(fields[3]); err != nil {
+		return CronSchedule{}, cronError(data, err)
+	}
+	if s.daysOfWeek, s.anyDayOfWeek, err = cronDayOfWeek.parse(fields[4]); err != nil {
+		return CronSchedule{}, cronError(data, err)
+	}
+	if s.daysOfWeek&(1<<7) != 0 {
+		// Sunday is 0 or 7: keep a single bit, so that equal schedules have equal fields
+		s.daysOfWeek = s.daysOfWeek&^(1<<7) | 1
+	}
+
+	if !s.canFire() {
+		return CronSchedule{}, fmt.Errorf("invalid cron expression %q: the schedule never fires: %w", data, ErrFormat)
+	}
+
+	return s, nil
+}
+
+func cronError(data string, err error) error {
+	return fmt.Errorf("invalid cron expression %q: %w: %w", data, err, ErrFormat)
+}
+
+// parse parses a field into a bit set of the values it matches.
+//
+// It also tells if the field matches any value, i.e. is "*" or "?".
+func (f cronField) parse(field string) (uint64, bool, error) {
+	if field == "*" || (field == "?" && f.noSpecific) {
+		return f.span(f.min, f.max, 1), true, nil
+	}
+
+	var bits uint64
+	for part := range strings.SplitSeq(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step < 1 || step > f.max {
+				return 0, false, fmt.Errorf("invalid step %q in %s field", stepStr, f.name)
+			}
+		}
+
+		var low, high int
+		switch {
+		case rng == "*":
+			low, high = f.min, f.max
+		case strings.Contains(rng, "-"):
+			lowStr, highStr, _ := strings.Cut(rng, "-")
+			var err error
+			if low, err = f.value(lowStr); err != nil {
+				return 0, false, err
+			}
+			if high, err = f.value(highStr); err != nil {
+				return 0, false, err
+			}
+			if low > high {
+				return 0, false, fmt.Errorf("invalid range %q in %s field", rng, f.name)
+			}
+		default:
+			var err error
+			if low, err = f.value(rng); err != nil {
+				return 0, false, err
+			}
+			high = low
+			if hasStep {
+				// "5/15" stands for "5-max/15"
+				high = f.max
+			}
+		}
+
+		bits |= f.span(low, high, step)
+	}
+
+	return bits, false, nil
+}
+
+// value parses a single value of the field, either a number or a name.
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+
+	v, ok := atoiDigits(s)
+	if !ok || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field: expected %d-%d", s, f.name, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// span returns the bit set of the values from low to high, every step.
+func (f cronField) span(low, high, step int) uint64 {
+	var bits uint64
+	for v := low; v <= high; v += step {
+		bits |= 1 << uint(v) //nolint:gosec // values are bounded by the field range
+	}
+
+	return bits
+}
+
+// CronSchedule is a parsed cron expression.
+//
+// Use [ParseCron] to build a [CronSchedule].
+type CronSchedule struct {
+	seconds, minutes, hours, daysOfMonth, months, daysOfWeek uint64
+
+	withSeconds, anyDayOfMonth, anyDayOfWeek bool
+}
+
+// HasSeconds tells if the schedule has been specified with a seconds field.
+func (s CronSchedule) HasSeconds() bool {
+	return s.withSeconds
+}
+
+// canFire tells if the days of month can match any of the months.
+func (s CronSchedule) canFire() bool {
+	if !s.anyDayOfWeek {
+		return true
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		if s.months&(1<<uint(month)) == 0 {
+			continue
+		}
+		maxDay := daysIn(month, 2000) // a leap year
+		for day := 1; day <= maxDay; day++ {
+			if s.daysOfMonth&(1<<uint(day)) != 0 { //nolint:gosec // day is bounded
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Next returns the next n fire times strictly after from, in the given location.
+//
+// A nil location stands for the location of from.
+//
+// Fewer than n times are returned if the schedule does not fire within the next 9 years.
+func (s CronSchedule) Next(from DateTime, loc *time.Location, n int) []DateTime {
+	t := time.Time(from)
+	if loc != nil {
+		t = t.In(loc)
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	times := make([]DateTime, 0, n)
+	for range n {
+		next, ok := s.next(t)
+		if !ok {
+			break
+		}
+		times = append(times, DateTime(next))
+		t = next
+	}
+
+	return times
+}
+
+// next returns the first fire time strictly after t, in the location of t.
+//
+// The schedule applies to the wall clock of the location. On daylight saving time transitions:
+//
+//   - wall times which are skipped fire at the instant they normalize to, e.g. 02:30 fires at 03:30
+//     when clocks move forward from 02:00 to 03:00
+//   - wall times which are repeated fire once, at their first occurrence
+func (s CronSchedule) next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	wall := wallClock(t)
+
+	for {
+		var ok bool
+		if wall, ok = s.nextWall(wall); !ok {
+			return time.Time{}, false
+		}
+
+		next := wallClockIn(wall, loc)
+		if skipped := wall.Sub(wallClock(next)); skipped > 0 {
+			// time.Date resolves skipped wall times with the offset after the transition: move past the gap
+			next = next.Add(skipped)
+		}
+		if next.After(t) {
+			return next, true
+		}
+		// a repeated wall time, which already fired at its first occurrence
+	}
+}
+
+// nextWall returns the first wall clock time matching the schedule strictly after the wall clock t, in UTC.
+//
+//nolint:gocognit,gocyclo,cyclop // the search advances each field in turn, from month to second
+func (s CronSchedule) nextWall(t time.Time) (time.Time, bool) {
+	loc := time.UTC
+	t = t.Add(time.Second - time.Duration(t.Nanosecond()))
+	yearLimit := t.Year() + cronSearchYears
+
+	// when a field does not match, the smaller fields are reset to their first value once
+	added := false
+
+wrap:
+	for t.Year() <= yearLimit {
+		for s.months&(1<<uint(t.Month())) == 0 {
+			if !added {
+				added = true
+				t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+			}
+			t = t.AddDate(0, 1, 0)
+			if t.Month() == time.January {
+				continue wrap
+			}
+		}
+
+		for !s.matchesDay(t) {
+			if !added {
+				added = true
+				t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+			}
+			t = t.AddDate(0, 0, 1)
+			if t.Day() == 1 {
+				continue wrap
+			}
+		}
+
+		for s.hours&(1<<uint(t.Hour())) == 0 { //nolint:gosec // hours are bounded
+			if !added {
+				added = true
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+			}
+			t = t.Add(time.Hour)
+			if t.Hour() == 0 {
+				continue wrap
+			}
+		}
+
+		for s.minutes&(1<<uint(t.Minute())) == 0 { //nolint:gosec // minutes are bounded
+			if !added {
+				added = true
+				t = t.Truncate(time.Minute)
+			}
+			t = t.Add(time.Minute)
+			if t.Minute() == 0 {
+				continue wrap
+			}
+		}
+
+		for s.seconds&(1<<uint(t.Second())) == 0 { //nolint:gosec // seconds are bounded
+			if !added {
+				added = true
+				t = t.Truncate(time.Second)
+			}
+			t = t.Add(time.Second)
+			if t.Second() == 0 {
+				continue wrap
+			}
+		}
+
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+// matchesDay tells if the day of t matches the day-of-month and day-of-week fields.
+func (s CronSchedule) matchesDay(t time.Time) bool {
+	domMatch := s.daysOfMonth&(1<<uint(t.Day())) != 0    //nolint:gosec // days are bounded
+	dowMatch := s.daysOfWeek&(1<<uint(t.Weekday())) != 0 //nolint:gosec // weekdays are bounded
+
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+// Cron represents a cron expression, e.g. "*/15 9-17 * * MON-FRI" or "@daily".
+//
+// See [ParseCron] for the accepted syntax.
+//
+// swagger:strfmt cron.
+type Cron string
+
+// cronFormat describes the [Cron] format.
+type cronFormat struct{}
+
+func (cronFormat) FormatName() string { return "cron" }
+
+func (cronFormat) IsValid(s string) bool { return IsCron(s) }
+
+// Schedule parses the cron expression.
+func (c Cron) Schedule() (CronSchedule, error) {
+	return ParseCron(string(c))
+}
+
+// Next returns the next n fire times of the cron expression strictly after from, in the given location.
+//
+// See [CronSchedule.Next].
+func (c Cron) Next(from DateTime, loc *time.Location, n int) ([]DateTime, error) {
+	s, err := c.Schedule()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Next(from, loc, n), nil
+}
+
+// MarshalText turns this instance into text.
+func (c Cron) MarshalText() ([]byte, error) {
+	return StringFormat[cronFormat](c).MarshalText()
+}
+
+// UnmarshalText hydrates this instance from text.
+func (c *Cron) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[cronFormat])(c).UnmarshalText(data)
+}
+
+// Scan read a value from a database driver.
+func (c *Cron) Scan(raw any) error {
+	return (*StringFormat[cronFormat])(c).Scan(raw)
+}
+
+// Value converts a value to a database driver value.
+func (c Cron) Value() (driver.Value, error) {
+	return StringFormat[cronFormat](c).Value()
+}
+
+func (c Cron) String() string {
+	return string(c)
+}
+
+// MarshalJSON returns the [Cron] as JSON.
+func (c Cron) MarshalJSON() ([]byte, error) {
+	return StringFormat[cronFormat](c).MarshalJSON()
+}
+
+// UnmarshalJSON sets the [Cron] from JSON.
+func (c *Cron) UnmarshalJSON(data []byte) error {
+	return (*StringFormat[cronFormat])(c).UnmarshalJSON(data)
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (c *Cron) DeepCopyInto(out *Cron) {
+	(*StringFormat[cronFormat])(c).DeepCopyInto((*StringFormat[cronFormat])(out))
+}
+
+// DeepCopy copies the receiver into a new [Cron].
+func (c *Cron) DeepCopy() *Cron {
+	return (*Cron)((*StringFormat[cronFormat])(c).DeepCopy())
+}
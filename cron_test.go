@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestFormatCron(t *testing.T) {
+	c := Cron("@daily")
+	testStringFormat(t, &c, "cron", "*/15 9-17 * * MON-FRI",
+		[]string{
+			"* * * * *",
+			"0 0 * * *",
+			"*/5 * * * *",
+			"0 9-17/2 * * 1-5",
+			"0 0 1,15 * *",
+			"0 12 * JAN,jul ?",
+			"0 0 ? * SUN",
+			"0 0 * * 7",
+			"5/15 * * * *",
+			"30 0 0 * * *",
+			"0  0   *  * *",
+			"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly", "@DAILY",
+		},
+		[]string{
+			"",
+			"* * * *",
+			"* * * * * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"* * 32 * *",
+			"* * * 13 *",
+			"* * * * 8",
+			"5-1 * * * *",
+			"*/0 * * * *",
+			"*/61 * * * *",
+			"? * * * *",
+			"* * * ? *",
+			"1,,2 * * * *",
+			"a * * * *",
+			"* * * FOO *",
+			"@reboot",
+			"@every 1h",
+			"0 0 30 2 *",
+			"0 0 31 4,6,9,11 *",
+		},
+	)
+}
+
+func TestCron_Next(t *testing.T) {
+	from := DateTime(time.Date(2024, time.May, 1, 10, 7, 30, 0, time.UTC))
+
+	for _, tc := range []struct {
+		expr     string
+		expected []string
+	}{
+		{"*/15 * * * *", []string{"2024-05-01T10:15:00Z", "2024-05-01T10:30:00Z", "2024-05-01T10:45:00Z"}},
+		{"@daily", []string{"2024-05-02T00:00:00Z", "2024-05-03T00:00:00Z", "2024-05-04T00:00:00Z"}},
+		{"@monthly", []string{"2024-06-01T00:00:00Z", "2024-07-01T00:00:00Z", "2024-08-01T00:00:00Z"}},
+		{"0 9 * * MON-FRI", []string{"2024-05-02T09:00:00Z", "2024-05-03T09:00:00Z", "2024-05-06T09:00:00Z"}},
+		{"*/20 7 10 * * *", []string{"2024-05-01T10:07:40Z", "2024-05-02T10:07:00Z", "2024-05-02T10:07:20Z"}},
+		{"0 0 29 2 *", []string{"2028-02-29T00:00:00Z", "2032-02-29T00:00:00Z", "2036-02-29T00:00:00Z"}},
+		// day-of-month or day-of-week, when both are restricted
+		{"0 0 13 * FRI", []string{"2024-05-03T00:00:00Z", "2024-05-10T00:00:00Z", "2024-05-13T00:00:00Z"}},
+		{"0 0 31 * *", []string{"2024-05-31T00:00:00Z", "2024-07-31T00:00:00Z", "2024-08-31T00:00:00Z"}},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+			times, err := Cron(tc.expr).Next(from, time.UTC, len(tc.expected))
+			require.NoError(t, err)
+			require.Len(t, times, len(tc.expected))
+			for i, expected := range tc.expected {
+				assert.EqualT(t, expected, time.Time(times[i]).Format(time.RFC3339))
+			}
+		})
+	}
+
+	t.Run("should be strictly after from", func(t *testing.T) {
+		exact := DateTime(time.Date(2024, time.May, 1, 10, 15, 0, 0, time.UTC))
+		times, err := Cron("*/15 * * * *").Next(exact, nil, 1)
+		require.NoError(t, err)
+		assert.EqualT(t, "2024-05-01T10:30:00Z", time.Time(times[0]).Format(time.RFC3339))
+	})
+
+	t.Run("should fail on invalid expression", func(t *testing.T) {
+		_, err := Cron("* * *").Next(from, time.UTC, 1)
+		require.ErrorIs(t, err, ErrFormat)
+	})
+}
+
+func TestCron_Next_Location(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	from := DateTime(time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)) // 12:00 in Paris
+	times, err := Cron("0 9 * * *").Next(from, paris, 2)
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	assert.EqualT(t, "2024-05-02T09:00:00+02:00", time.Time(times[0]).Format(time.RFC3339))
+	assert.EqualT(t, paris, time.Time(times[0]).Location())
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	format := func(times []DateTime) []string {
+		formatted := make([]string, 0, len(times))
+		for _, dt := range times {
+			formatted = append(formatted, time.Time(dt).Format(time.RFC3339))
+		}
+		return formatted
+	}
+
+	t.Run("should fire skipped times at the normalized instant", func(t *testing.T) {
+		// on 2024-03-10 in New York, clocks jump from 02:00 to 03:00
+		from := DateTime(time.Date(2024, time.March, 9, 12, 0, 0, 0, newYork))
+		times, err := Cron("30 2 * * *").Next(from, newYork, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"2024-03-10T03:30:00-04:00",
+			"2024-03-11T02:30:00-04:00",
+			"2024-03-12T02:30:00-04:00",
+		}, format(times))
+
+		from = DateTime(time.Date(2024, time.March, 10, 1, 40, 0, 0, newYork))
+		times, err = Cron("*/30 * * * *").Next(from, newYork, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"2024-03-10T03:00:00-04:00",
+			"2024-03-10T03:30:00-04:00",
+			"2024-03-10T04:00:00-04:00",
+		}, format(times))
+	})
+
+	t.Run("should fire repeated times once", func(t *testing.T) {
+		// on 2024-11-03 in New York, clocks go back from 02:00 to 01:00
+		from := DateTime(time.Date(2024, time.November, 2, 12, 0, 0, 0, newYork))
+		times, err := Cron("30 1 * * *").Next(from, newYork, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"2024-11-03T01:30:00-04:00",
+			"2024-11-04T01:30:00-05:00",
+			"2024-11-05T01:30:00-05:00",
+		}, format(times))
+
+		from = DateTime(time.Date(2024, time.November, 3, 0, 40, 0, 0, newYork))
+		times, err = Cron("*/30 * * * *").Next(from, newYork, 4)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"2024-11-03T01:00:00-04:00",
+			"2024-11-03T01:30:00-04:00",
+			"2024-11-03T02:00:00-05:00",
+			"2024-11-03T02:30:00-05:00",
+		}, format(times))
+	})
+}
+
+func TestCron_Schedule(t *testing.T) {
+	s, err := Cron("30 0 0 * * *").Schedule()
+	require.NoError(t, err)
+	assert.TrueT(t, s.HasSeconds())
+
+	s, err = ParseCron("@hourly")
+	require.NoError(t, err)
+	assert.FalseT(t, s.HasSeconds())
+
+	assert.Empty(t, CronSchedule{}.Next(DateTime(time.Now()), nil, 3))
+	assert.Nil(t, s.Next(DateTime(time.Now()), nil, 0))
+	assert.Nil(t, s.Next(DateTime(time.Now()), nil, -1))
+
+	times, err := Cron("@hourly").Next(DateTime(time.Now()), nil, -1)
+	require.NoError(t, err)
+	assert.Nil(t, times)
+}
@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -25,28 +26,239 @@ const (
 	// RFC3339FullDate represents a full-date as specified by RFC3339.
 	// See: http://goo.gl/xXOvVd
 	RFC3339FullDate = "2006-01-02"
+	// ISO8601DateBasic represents an ISO8601 calendar date in basic format (no separator), e.g. "20240205".
+	ISO8601DateBasic = "20060102"
+	// ISO8601OrdinalDate represents an ISO8601 ordinal date (year and day of year), e.g. "2024-035".
+	ISO8601OrdinalDate = "2006-002"
+	// ISO8601OrdinalDateBasic represents an ISO8601 ordinal date in basic format, e.g. "2024035".
+	ISO8601OrdinalDateBasic = "2006002"
+	// ISO8601YearMonth represents an ISO8601 calendar date with reduced precision (year and month), e.g. "2024-05".
+	ISO8601YearMonth = "2006-01"
+	// ISO8601Year represents an ISO8601 calendar date with reduced precision (year only), e.g. "2024".
+	ISO8601Year = "2006"
+	// ISO8601WeekDate represents an ISO8601 week date (ISO year, week and day of week), e.g. "2024-W05-3".
+	//
+	// This is a pseudo-layout understood by [ParseDate] only: it is not a valid layout for [time.Parse].
+	ISO8601WeekDate = "2006-Www-D"
+	// ISO8601WeekDateBasic represents an ISO8601 week date in basic format, e.g. "2024W053".
+	//
+	// This is a pseudo-layout understood by [ParseDate] only: it is not a valid layout for [time.Parse].
+	ISO8601WeekDateBasic = "2006WwwD"
+	// ISO8601Week represents an ISO8601 week date with reduced precision (ISO year and week), e.g. "2024-W05".
+	// The resulting date is the Monday of that week.
+	//
+	// This is a pseudo-layout understood by [ParseDate] only: it is not a valid layout for [time.Parse].
+	ISO8601Week = "2006-Www"
 )
 
+//nolint:gochecknoglobals // package-level configuration for date parsing
+var (
+	// DateFormats is the collection of formats used by [ParseDate]().
+	//
+	// By default, only [RFC3339FullDate] is accepted. Alternative ISO 8601 representations
+	// may be enabled by adding layouts to this list, e.g. [ISO8601WeekDate], [ISO8601OrdinalDate],
+	// [ISO8601DateBasic], [ISO8601YearMonth] or [ISO8601Year]. See also [ISO8601DateFormats].
+	DateFormats = []string{
+		RFC3339FullDate,
+	}
+
+	// ISO8601DateFormats is the collection of all alternative ISO 8601 date representations
+	// supported by [ParseDate].
+	//
+	// Use it to opt-in all of them at once:
+	//
+	//	strfmt.DateFormats = strfmt.ISO8601DateFormats
+	ISO8601DateFormats = []string{
+		RFC3339FullDate,
+		ISO8601DateBasic,
+		ISO8601OrdinalDate,
+		ISO8601OrdinalDateBasic,
+		ISO8601WeekDate,
+		ISO8601WeekDateBasic,
+		ISO8601Week,
+		ISO8601YearMonth,
+		ISO8601Year,
+	}
+)
+
+// ParseDate parses a string that represents a date, using the layouts in [DateFormats].
+//
+// Reduced precision representations resolve to the first day of the period (e.g. "2024-05" is "2024-05-01"
+// and "2024-W05" is the Monday of week 5).
+func ParseDate(data string) (Date, error) {
+	return TimeConfig{}.ParseDate(data)
+}
+
+// parseDateLayouts parses a date with the first matching layout in formats.
+func parseDateLayouts(data string, formats []string, loc *time.Location) (Date, error) {
+	var lastError error
+	for _, layout := range formats {
+		var (
+			dd  time.Time
+			err error
+		)
+		switch layout {
+		case ISO8601WeekDate, ISO8601WeekDateBasic, ISO8601Week:
+			dd, err = parseWeekDate(layout, data, loc)
+		default:
+			dd, err = time.ParseInLocation(layout, data, loc)
+		}
+		if err != nil {
+			lastError = err
+			continue
+		}
+		return Date(dd), nil
+	}
+
+	if lastError == nil {
+		lastError = fmt.Errorf("no date format configured to parse %q: %w", data, ErrFormat)
+	}
+
+	return Date{}, lastError
+}
+
+// parseWeekDate parses an ISO 8601 week date such as "2024-W05-3", "2024W053" or "2024-W05".
+func parseWeekDate(layout, data string, loc *time.Location) (time.Time, error) {
+	const (
+		yearLen = 4
+		weekLen = 2
+	)
+	fail := func() (time.Time, error) {
+		return time.Time{}, fmt.Errorf("parsing week date %q as %q: %w", data, layout, ErrFormat)
+	}
+
+	rest := data
+	if len(rest) < yearLen {
+		return fail()
+	}
+	year, ok := atoiDigits(rest[:yearLen])
+	if !ok {
+		return fail()
+	}
+	rest = rest[yearLen:]
+
+	prefix := "W"
+	if layout != ISO8601WeekDateBasic {
+		prefix = "-W"
+	}
+	rest, found := strings.CutPrefix(rest, prefix)
+	if !found || len(rest) < weekLen {
+		return fail()
+	}
+	week, ok := atoiDigits(rest[:weekLen])
+	if !ok {
+		return fail()
+	}
+	rest = rest[weekLen:]
+
+	weekday := 1
+	switch layout {
+	case ISO8601Week:
+		if rest != "" {
+			return fail()
+		}
+	case ISO8601WeekDate:
+		if rest, found = strings.CutPrefix(rest, "-"); !found {
+			return fail()
+		}
+		fallthrough
+	default:
+		if len(rest) != 1 {
+			return fail()
+		}
+		if weekday, ok = atoiDigits(rest); !ok {
+			return fail()
+		}
+	}
+
+	if week < 1 || week > isoWeeksInYear(year) || weekday < 1 || weekday > daysInWeek {
+		return fail()
+	}
+
+	return isoWeekStart(year, loc).AddDate(0, 0, (week-1)*daysInWeek+weekday-1), nil
+}
+
+// isoWeekStart returns the Monday of the first ISO week of the year.
+//
+// The first ISO week is the week that contains January 4th.
+func isoWeekStart(year int, loc *time.Location) time.Time {
+	const firstThursday = 4
+	jan4 := time.Date(year, time.January, firstThursday, 0, 0, 0, 0, loc)
+	offset := (int(jan4.Weekday()) + daysInWeek - 1) % daysInWeek // days since Monday
+
+	return jan4.AddDate(0, 0, -offset)
+}
+
+// isoWeeksInYear returns the number of ISO weeks (52 or 53) in the year.
+func isoWeeksInYear(year int) int {
+	const lastWeekDay = 28 // December 28th always falls in the last week of the year
+	_, week := time.Date(year, time.December, lastWeekDay, 0, 0, 0, 0, time.UTC).ISOWeek()
+
+	return week
+}
+
+// atoiDigits converts a string made only of ASCII digits into an int.
+func atoiDigits(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	n := 0
+	for i := range len(s) {
+		if !isASCIIDigit(s[i]) {
+			return 0, false
+		}
+		n = n*decimalBase + int(s[i]-'0')
+	}
+
+	return n, true
+}
+
 // Date represents a date from the API.
 //
+// A [Date] is held as midnight of that day in [DefaultTimeLocation]. Values read by [Date.Scan],
+// [Date.UnmarshalBinary] and [Date.UnmarshalBSON] are normalized accordingly: the time of day is dropped and the
+// calendar day is the one seen in the location of the source value.
+//
+// Use [CivilDate] for a calendar date which does not depend on any time zone.
+//
 // swagger:strfmt date.
 type Date time.Time
 
+// dateOf returns the calendar day of t, as seen in the location of t, at midnight in loc.
+//
+// The zero time yields the zero [Date].
+func dateOf(t time.Time, loc *time.Location) Date {
+	if t.IsZero() {
+		return Date{}
+	}
+	year, month, day := t.Date()
+
+	return Date(time.Date(year, month, day, 0, 0, 0, 0, loc))
+}
+
 // String converts this date into a string.
 func (d Date) String() string {
 	return time.Time(d).Format(RFC3339FullDate)
 }
 
+// Format renders the date with a layout, like [time.Time.Format] does, e.g. "Monday, January 2 2006".
+//
+// Layout elements which stand for the time of day render as midnight.
+func (d Date) Format(layout string) string {
+	return time.Time(d).Format(layout)
+}
+
 // UnmarshalText parses a text representation into a date type.
 func (d *Date) UnmarshalText(text []byte) error {
 	if len(text) == 0 {
+		*d = Date{}
 		return nil
 	}
-	dd, err := time.ParseInLocation(RFC3339FullDate, string(text), DefaultTimeLocation)
+	dd, err := ParseDate(string(text))
 	if err != nil {
 		return err
 	}
-	*d = Date(dd)
+	*d = dd
 	return nil
 }
 
@@ -63,7 +275,7 @@ func (d *Date) Scan(raw any) error {
 	case string:
 		return d.UnmarshalText([]byte(v))
 	case time.Time:
-		*d = Date(v)
+		*d = dateOf(v, DefaultTimeLocation)
 		return nil
 	case nil:
 		*d = Date{}
@@ -92,12 +304,7 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &strdate); err != nil {
 		return err
 	}
-	tt, err := time.ParseInLocation(RFC3339FullDate, strdate, DefaultTimeLocation)
-	if err != nil {
-		return err
-	}
-	*d = Date(tt)
-	return nil
+	return d.UnmarshalText([]byte(strdate))
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
@@ -139,7 +346,7 @@ func (d *Date) UnmarshalBinary(data []byte) error {
 		return err
 	}
 
-	*d = Date(original)
+	*d = dateOf(original, DefaultTimeLocation)
 
 	return nil
 }
@@ -152,3 +152,102 @@ func TestDate_Equal(t *testing.T) {
 	assert.TrueT(t, d1.Equal(d2), "Date instances should be equal")
 	assert.FalseT(t, d1.Equal(d3), "Date instances should not be equal")
 }
+
+func TestParseDate_ISO8601(t *testing.T) {
+	t.Run("should only accept RFC3339 full-date by default", func(t *testing.T) {
+		_, err := ParseDate("2024-02-05")
+		require.NoError(t, err)
+
+		for _, value := range []string{"20240205", "2024-036", "2024-W06-1", "2024-02", "2024"} {
+			_, err := ParseDate(value)
+			require.Error(t, err, "expected %q to be rejected", value)
+		}
+	})
+
+	t.Run("should parse alternate representations when enabled", func(t *testing.T) {
+		orig := DateFormats
+		t.Cleanup(func() { DateFormats = orig })
+		DateFormats = ISO8601DateFormats
+
+		tests := []struct {
+			in       string
+			expected string
+		}{
+			{"2024-02-05", "2024-02-05"},
+			{"20240205", "2024-02-05"},
+			{"2024-036", "2024-02-05"},
+			{"2024036", "2024-02-05"},
+			{"2024-W06-1", "2024-02-05"},
+			{"2024W061", "2024-02-05"},
+			{"2024-W05-3", "2024-01-31"},
+			{"2024-W06", "2024-02-05"},
+			{"2020-W01-1", "2019-12-30"}, // ISO week-numbering year differs from the calendar year
+			{"2020-W53-7", "2021-01-03"}, // 2020 has 53 ISO weeks
+			{"2024-366", "2024-12-31"},
+			{"2024-05", "2024-05-01"},
+			{"2024", "2024-01-01"},
+		}
+		for _, tt := range tests {
+			d, err := ParseDate(tt.in)
+			require.NoError(t, err, "parsing %q", tt.in)
+			assert.EqualT(t, tt.expected, d.String(), "parsing %q", tt.in)
+
+			var u Date
+			require.NoError(t, u.UnmarshalText([]byte(tt.in)))
+			assert.EqualT(t, d, u)
+		}
+
+		for _, value := range []string{
+			"2021-W53-1", // 2021 has only 52 ISO weeks
+			"2024-W00-1",
+			"2024-W06-8",
+			"2024-W06-0",
+			"2024-W6-1",
+			"2024W06-1",
+			"2024-W06-1x",
+			"2024-W061",
+			"2023-366",
+			"2024-13",
+			"20241305",
+			"24",
+			"yada",
+		} {
+			_, err := ParseDate(value)
+			require.Error(t, err, "expected %q to be rejected", value)
+		}
+	})
+
+	t.Run("should fail with no configured format", func(t *testing.T) {
+		orig := DateFormats
+		t.Cleanup(func() { DateFormats = orig })
+		DateFormats = nil
+
+		_, err := ParseDate("2024-02-05")
+		require.ErrorIs(t, err, ErrFormat)
+	})
+}
+
+func TestDate_Normalize(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	// 2024-05-01 in Tokyo is still April 30 in UTC
+	ref := time.Date(2024, time.May, 1, 8, 30, 0, 0, tokyo)
+	expected := Date(time.Date(2024, time.May, 1, 0, 0, 0, 0, DefaultTimeLocation))
+
+	t.Run("with Scan", func(t *testing.T) {
+		var d Date
+		require.NoError(t, d.Scan(ref))
+		assert.EqualT(t, expected, d)
+
+		require.NoError(t, d.Scan(time.Time{}))
+		assert.EqualT(t, Date{}, d)
+	})
+
+	t.Run("with UnmarshalBinary", func(t *testing.T) {
+		data, err := ref.MarshalBinary()
+		require.NoError(t, err)
+
+		var d Date
+		require.NoError(t, d.UnmarshalBinary(data))
+		assert.EqualT(t, expected, d)
+	})
+}
@@ -400,67 +400,67 @@ func init() { //nolint:gochecknoinits // registers all default string formats in
 	//   - uuid5
 	//   - uuid7
 	u := URI("")
-	Default.Add("uri", &u, isRequestURI)
+	Default.Add("uri", &u, uriFormat{}.IsValid)
 
 	eml := Email("")
-	Default.Add("email", &eml, IsEmail)
+	Default.Add("email", &eml, emailFormat{}.IsValid)
 
 	hn := Hostname("")
-	Default.Add("hostname", &hn, IsHostname)
+	Default.Add("hostname", &hn, hostnameFormat{}.IsValid)
 
 	ip4 := IPv4("")
-	Default.Add("ipv4", &ip4, isIPv4)
+	Default.Add("ipv4", &ip4, ipv4Format{}.IsValid)
 
 	ip6 := IPv6("")
-	Default.Add("ipv6", &ip6, isIPv6)
+	Default.Add("ipv6", &ip6, ipv6Format{}.IsValid)
 
 	cidr := CIDR("")
-	Default.Add("cidr", &cidr, isCIDR)
+	Default.Add("cidr", &cidr, cidrFormat{}.IsValid)
 
 	mac := MAC("")
-	Default.Add("mac", &mac, isMAC)
+	Default.Add("mac", &mac, macFormat{}.IsValid)
 
 	uid := UUID("")
-	Default.Add("uuid", &uid, IsUUID)
+	Default.Add("uuid", &uid, uuidFormat{}.IsValid)
 
 	uid3 := UUID3("")
-	Default.Add("uuid3", &uid3, IsUUID3)
+	Default.Add("uuid3", &uid3, uuid3Format{}.IsValid)
 
 	uid4 := UUID4("")
-	Default.Add("uuid4", &uid4, IsUUID4)
+	Default.Add("uuid4", &uid4, uuid4Format{}.IsValid)
 
 	uid5 := UUID5("")
-	Default.Add("uuid5", &uid5, IsUUID5)
+	Default.Add("uuid5", &uid5, uuid5Format{}.IsValid)
 
 	uid7 := UUID7("")
-	Default.Add("uuid7", &uid7, IsUUID7)
+	Default.Add("uuid7", &uid7, uuid7Format{}.IsValid)
 
 	isbn := ISBN("")
-	Default.Add("isbn", &isbn, func(str string) bool { return isISBN10(str) || isISBN13(str) })
+	Default.Add("isbn", &isbn, isbnFormat{}.IsValid)
 
 	isbn10 := ISBN10("")
-	Default.Add("isbn10", &isbn10, isISBN10)
+	Default.Add("isbn10", &isbn10, isbn10Format{}.IsValid)
 
 	isbn13 := ISBN13("")
-	Default.Add("isbn13", &isbn13, isISBN13)
+	Default.Add("isbn13", &isbn13, isbn13Format{}.IsValid)
 
 	cc := CreditCard("")
-	Default.Add("creditcard", &cc, isCreditCard)
+	Default.Add("creditcard", &cc, creditCardFormat{}.IsValid)
 
 	ssn := SSN("")
-	Default.Add("ssn", &ssn, isSSN)
+	Default.Add("ssn", &ssn, ssnFormat{}.IsValid)
 
 	hc := HexColor("")
-	Default.Add("hexcolor", &hc, isHexcolor)
+	Default.Add("hexcolor", &hc, hexColorFormat{}.IsValid)
 
 	rc := RGBColor("")
-	Default.Add("rgbcolor", &rc, isRGBcolor)
+	Default.Add("rgbcolor", &rc, rgbColorFormat{}.IsValid)
 
 	b64 := Base64([]byte(nil))
 	Default.Add("byte", &b64, isBase64)
 
 	pw := Password("")
-	Default.Add("password", &pw, func(_ string) bool { return true })
+	Default.Add("password", &pw, passwordFormat{}.IsValid)
 }
 
 // Base64 represents a base64 encoded string, using URLEncoding alphabet.
@@ -507,6 +507,8 @@ func (b *Base64) Scan(raw any) error {
 			return err
 		}
 		*b = Base64(vv)
+	case nil:
+		*b = nil
 	default:
 		return fmt.Errorf("cannot sql.Scan() strfmt.Base64 from: %#v: %w", v, ErrFormat)
 	}
@@ -530,6 +532,9 @@ func (b Base64) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON sets the Base64 from JSON.
 func (b *Base64) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
 	var b64str string
 	if err := json.Unmarshal(data, &b64str); err != nil {
 		return err
@@ -562,34 +567,31 @@ func (b *Base64) DeepCopy() *Base64 {
 // swagger:strfmt uri.
 type URI string
 
+// uriFormat describes the [URI] format.
+type uriFormat struct{}
+
+func (uriFormat) FormatName() string { return "uri" }
+
+func (uriFormat) IsValid(s string) bool { return isRequestURI(s) }
+
 // MarshalText turns this instance into text.
 func (u URI) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[uriFormat](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *URI) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = URI(string(data))
-	return nil
+func (u *URI) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[uriFormat])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *URI) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = URI(string(v))
-	case string:
-		*u = URI(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.URI from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[uriFormat])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u URI) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[uriFormat](u).Value()
 }
 
 func (u URI) String() string {
@@ -598,32 +600,22 @@ func (u URI) String() string {
 
 // MarshalJSON returns the [URI] as JSON.
 func (u URI) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[uriFormat](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [URI] from JSON.
 func (u *URI) UnmarshalJSON(data []byte) error {
-	var uristr string
-	if err := json.Unmarshal(data, &uristr); err != nil {
-		return err
-	}
-	*u = URI(uristr)
-	return nil
+	return (*StringFormat[uriFormat])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *URI) DeepCopyInto(out *URI) {
-	*out = *u
+	(*StringFormat[uriFormat])(u).DeepCopyInto((*StringFormat[uriFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [URI].
 func (u *URI) DeepCopy() *URI {
-	if u == nil {
-		return nil
-	}
-	out := new(URI)
-	u.DeepCopyInto(out)
-	return out
+	return (*URI)((*StringFormat[uriFormat])(u).DeepCopy())
 }
 
 // Email represents the email string format as specified by the [json] schema spec.
@@ -631,34 +623,31 @@ func (u *URI) DeepCopy() *URI {
 // swagger:strfmt email.
 type Email string
 
+// emailFormat describes the [Email] format.
+type emailFormat struct{}
+
+func (emailFormat) FormatName() string { return "email" }
+
+func (emailFormat) IsValid(s string) bool { return IsEmail(s) }
+
 // MarshalText turns this instance into text.
 func (e Email) MarshalText() ([]byte, error) {
-	return []byte(string(e)), nil
+	return StringFormat[emailFormat](e).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (e *Email) UnmarshalText(data []byte) error { // validation is performed later on
-	*e = Email(string(data))
-	return nil
+func (e *Email) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[emailFormat])(e).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (e *Email) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*e = Email(string(v))
-	case string:
-		*e = Email(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.Email from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[emailFormat])(e).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (e Email) Value() (driver.Value, error) {
-	return driver.Value(string(e)), nil
+	return StringFormat[emailFormat](e).Value()
 }
 
 func (e Email) String() string {
@@ -667,32 +656,22 @@ func (e Email) String() string {
 
 // MarshalJSON returns the Email as JSON.
 func (e Email) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(e))
+	return StringFormat[emailFormat](e).MarshalJSON()
 }
 
 // UnmarshalJSON sets the Email from JSON.
 func (e *Email) UnmarshalJSON(data []byte) error {
-	var estr string
-	if err := json.Unmarshal(data, &estr); err != nil {
-		return err
-	}
-	*e = Email(estr)
-	return nil
+	return (*StringFormat[emailFormat])(e).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (e *Email) DeepCopyInto(out *Email) {
-	*out = *e
+	(*StringFormat[emailFormat])(e).DeepCopyInto((*StringFormat[emailFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new Email.
 func (e *Email) DeepCopy() *Email {
-	if e == nil {
-		return nil
-	}
-	out := new(Email)
-	e.DeepCopyInto(out)
-	return out
+	return (*Email)((*StringFormat[emailFormat])(e).DeepCopy())
 }
 
 // Hostname represents the hostname string format as specified by the [json] schema spec.
@@ -700,34 +679,31 @@ func (e *Email) DeepCopy() *Email {
 // swagger:strfmt hostname.
 type Hostname string
 
+// hostnameFormat describes the [Hostname] format.
+type hostnameFormat struct{}
+
+func (hostnameFormat) FormatName() string { return "hostname" }
+
+func (hostnameFormat) IsValid(s string) bool { return IsHostname(s) }
+
 // MarshalText turns this instance into text.
 func (h Hostname) MarshalText() ([]byte, error) {
-	return []byte(string(h)), nil
+	return StringFormat[hostnameFormat](h).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (h *Hostname) UnmarshalText(data []byte) error { // validation is performed later on
-	*h = Hostname(string(data))
-	return nil
+func (h *Hostname) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[hostnameFormat])(h).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (h *Hostname) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*h = Hostname(string(v))
-	case string:
-		*h = Hostname(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.Hostname from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[hostnameFormat])(h).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (h Hostname) Value() (driver.Value, error) {
-	return driver.Value(string(h)), nil
+	return StringFormat[hostnameFormat](h).Value()
 }
 
 func (h Hostname) String() string {
@@ -736,32 +712,22 @@ func (h Hostname) String() string {
 
 // MarshalJSON returns the [Hostname] as JSON.
 func (h Hostname) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(h))
+	return StringFormat[hostnameFormat](h).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [Hostname] from JSON.
 func (h *Hostname) UnmarshalJSON(data []byte) error {
-	var hstr string
-	if err := json.Unmarshal(data, &hstr); err != nil {
-		return err
-	}
-	*h = Hostname(hstr)
-	return nil
+	return (*StringFormat[hostnameFormat])(h).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (h *Hostname) DeepCopyInto(out *Hostname) {
-	*out = *h
+	(*StringFormat[hostnameFormat])(h).DeepCopyInto((*StringFormat[hostnameFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [Hostname].
 func (h *Hostname) DeepCopy() *Hostname {
-	if h == nil {
-		return nil
-	}
-	out := new(Hostname)
-	h.DeepCopyInto(out)
-	return out
+	return (*Hostname)((*StringFormat[hostnameFormat])(h).DeepCopy())
 }
 
 // IPv4 represents an IP v4 address.
@@ -769,34 +735,31 @@ func (h *Hostname) DeepCopy() *Hostname {
 // swagger:strfmt ipv4.
 type IPv4 string
 
+// ipv4Format describes the [IPv4] format.
+type ipv4Format struct{}
+
+func (ipv4Format) FormatName() string { return "ipv4" }
+
+func (ipv4Format) IsValid(s string) bool { return isIPv4(s) }
+
 // MarshalText turns this instance into text.
 func (u IPv4) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[ipv4Format](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *IPv4) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = IPv4(string(data))
-	return nil
+func (u *IPv4) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[ipv4Format])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *IPv4) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = IPv4(string(v))
-	case string:
-		*u = IPv4(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.IPv4 from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[ipv4Format])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u IPv4) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[ipv4Format](u).Value()
 }
 
 func (u IPv4) String() string {
@@ -805,32 +768,22 @@ func (u IPv4) String() string {
 
 // MarshalJSON returns the [IPv4] as JSON.
 func (u IPv4) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[ipv4Format](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [IPv4] from JSON.
 func (u *IPv4) UnmarshalJSON(data []byte) error {
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = IPv4(ustr)
-	return nil
+	return (*StringFormat[ipv4Format])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *IPv4) DeepCopyInto(out *IPv4) {
-	*out = *u
+	(*StringFormat[ipv4Format])(u).DeepCopyInto((*StringFormat[ipv4Format])(out))
 }
 
 // DeepCopy copies the receiver into a new [IPv4].
 func (u *IPv4) DeepCopy() *IPv4 {
-	if u == nil {
-		return nil
-	}
-	out := new(IPv4)
-	u.DeepCopyInto(out)
-	return out
+	return (*IPv4)((*StringFormat[ipv4Format])(u).DeepCopy())
 }
 
 // IPv6 represents an IP v6 address.
@@ -838,34 +791,31 @@ func (u *IPv4) DeepCopy() *IPv4 {
 // swagger:strfmt ipv6.
 type IPv6 string
 
+// ipv6Format describes the [IPv6] format.
+type ipv6Format struct{}
+
+func (ipv6Format) FormatName() string { return "ipv6" }
+
+func (ipv6Format) IsValid(s string) bool { return isIPv6(s) }
+
 // MarshalText turns this instance into text.
 func (u IPv6) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[ipv6Format](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *IPv6) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = IPv6(string(data))
-	return nil
+func (u *IPv6) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[ipv6Format])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *IPv6) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = IPv6(string(v))
-	case string:
-		*u = IPv6(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.IPv6 from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[ipv6Format])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u IPv6) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[ipv6Format](u).Value()
 }
 
 func (u IPv6) String() string {
@@ -874,32 +824,22 @@ func (u IPv6) String() string {
 
 // MarshalJSON returns the [IPv6] as JSON.
 func (u IPv6) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[ipv6Format](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [IPv6] from JSON.
 func (u *IPv6) UnmarshalJSON(data []byte) error {
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = IPv6(ustr)
-	return nil
+	return (*StringFormat[ipv6Format])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *IPv6) DeepCopyInto(out *IPv6) {
-	*out = *u
+	(*StringFormat[ipv6Format])(u).DeepCopyInto((*StringFormat[ipv6Format])(out))
 }
 
 // DeepCopy copies the receiver into a new [IPv6].
 func (u *IPv6) DeepCopy() *IPv6 {
-	if u == nil {
-		return nil
-	}
-	out := new(IPv6)
-	u.DeepCopyInto(out)
-	return out
+	return (*IPv6)((*StringFormat[ipv6Format])(u).DeepCopy())
 }
 
 // CIDR represents a Classless Inter-Domain Routing notation.
@@ -907,34 +847,31 @@ func (u *IPv6) DeepCopy() *IPv6 {
 // swagger:strfmt cidr.
 type CIDR string
 
+// cidrFormat describes the [CIDR] format.
+type cidrFormat struct{}
+
+func (cidrFormat) FormatName() string { return "cidr" }
+
+func (cidrFormat) IsValid(s string) bool { return isCIDR(s) }
+
 // MarshalText turns this instance into text.
 func (u CIDR) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[cidrFormat](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *CIDR) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = CIDR(string(data))
-	return nil
+func (u *CIDR) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[cidrFormat])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *CIDR) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = CIDR(string(v))
-	case string:
-		*u = CIDR(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.CIDR from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[cidrFormat])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u CIDR) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[cidrFormat](u).Value()
 }
 
 func (u CIDR) String() string {
@@ -943,32 +880,22 @@ func (u CIDR) String() string {
 
 // MarshalJSON returns the [CIDR] as JSON.
 func (u CIDR) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[cidrFormat](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [CIDR] from JSON.
 func (u *CIDR) UnmarshalJSON(data []byte) error {
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = CIDR(ustr)
-	return nil
+	return (*StringFormat[cidrFormat])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *CIDR) DeepCopyInto(out *CIDR) {
-	*out = *u
+	(*StringFormat[cidrFormat])(u).DeepCopyInto((*StringFormat[cidrFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [CIDR].
 func (u *CIDR) DeepCopy() *CIDR {
-	if u == nil {
-		return nil
-	}
-	out := new(CIDR)
-	u.DeepCopyInto(out)
-	return out
+	return (*CIDR)((*StringFormat[cidrFormat])(u).DeepCopy())
 }
 
 // MAC represents a 48 bit MAC address.
@@ -976,34 +903,31 @@ func (u *CIDR) DeepCopy() *CIDR {
 // swagger:strfmt mac.
 type MAC string
 
+// macFormat describes the [MAC] format.
+type macFormat struct{}
+
+func (macFormat) FormatName() string { return "mac" }
+
+func (macFormat) IsValid(s string) bool { return isMAC(s) }
+
 // MarshalText turns this instance into text.
 func (u MAC) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[macFormat](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *MAC) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = MAC(string(data))
-	return nil
+func (u *MAC) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[macFormat])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *MAC) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = MAC(string(v))
-	case string:
-		*u = MAC(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.IPv4 from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[macFormat])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u MAC) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[macFormat](u).Value()
 }
 
 func (u MAC) String() string {
@@ -1012,32 +936,22 @@ func (u MAC) String() string {
 
 // MarshalJSON returns the [MAC] as JSON.
 func (u MAC) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[macFormat](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [MAC] from JSON.
 func (u *MAC) UnmarshalJSON(data []byte) error {
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = MAC(ustr)
-	return nil
+	return (*StringFormat[macFormat])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *MAC) DeepCopyInto(out *MAC) {
-	*out = *u
+	(*StringFormat[macFormat])(u).DeepCopyInto((*StringFormat[macFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [MAC].
 func (u *MAC) DeepCopy() *MAC {
-	if u == nil {
-		return nil
-	}
-	out := new(MAC)
-	u.DeepCopyInto(out)
-	return out
+	return (*MAC)((*StringFormat[macFormat])(u).DeepCopy())
 }
 
 // UUID represents a [uuid] string format
@@ -1045,34 +959,31 @@ func (u *MAC) DeepCopy() *MAC {
 // swagger:strfmt uuid.
 type UUID string
 
+// uuidFormat describes the [UUID] format.
+type uuidFormat struct{}
+
+func (uuidFormat) FormatName() string { return "uuid" }
+
+func (uuidFormat) IsValid(s string) bool { return IsUUID(s) }
+
 // MarshalText turns this instance into text.
 func (u UUID) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[uuidFormat](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *UUID) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = UUID(string(data))
-	return nil
+func (u *UUID) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[uuidFormat])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *UUID) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = UUID(string(v))
-	case string:
-		*u = UUID(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.UUID from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[uuidFormat])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u UUID) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[uuidFormat](u).Value()
 }
 
 func (u UUID) String() string {
@@ -1081,35 +992,22 @@ func (u UUID) String() string {
 
 // MarshalJSON returns the [UUID] as JSON.
 func (u UUID) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[uuidFormat](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [UUID] from JSON.
 func (u *UUID) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = UUID(ustr)
-	return nil
+	return (*StringFormat[uuidFormat])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *UUID) DeepCopyInto(out *UUID) {
-	*out = *u
+	(*StringFormat[uuidFormat])(u).DeepCopyInto((*StringFormat[uuidFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [UUID].
 func (u *UUID) DeepCopy() *UUID {
-	if u == nil {
-		return nil
-	}
-	out := new(UUID)
-	u.DeepCopyInto(out)
-	return out
+	return (*UUID)((*StringFormat[uuidFormat])(u).DeepCopy())
 }
 
 // UUID3 represents a uuid3 string format.
@@ -1117,34 +1015,31 @@ func (u *UUID) DeepCopy() *UUID {
 // swagger:strfmt uuid3.
 type UUID3 string
 
+// uuid3Format describes the [UUID3] format.
+type uuid3Format struct{}
+
+func (uuid3Format) FormatName() string { return "uuid3" }
+
+func (uuid3Format) IsValid(s string) bool { return IsUUID3(s) }
+
 // MarshalText turns this instance into text.
 func (u UUID3) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[uuid3Format](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *UUID3) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = UUID3(string(data))
-	return nil
+func (u *UUID3) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[uuid3Format])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *UUID3) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = UUID3(string(v))
-	case string:
-		*u = UUID3(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.UUID3 from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[uuid3Format])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u UUID3) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[uuid3Format](u).Value()
 }
 
 func (u UUID3) String() string {
@@ -1153,35 +1048,22 @@ func (u UUID3) String() string {
 
 // MarshalJSON returns the [UUID3] as JSON.
 func (u UUID3) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[uuid3Format](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [UUID3] from JSON.
 func (u *UUID3) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = UUID3(ustr)
-	return nil
+	return (*StringFormat[uuid3Format])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *UUID3) DeepCopyInto(out *UUID3) {
-	*out = *u
+	(*StringFormat[uuid3Format])(u).DeepCopyInto((*StringFormat[uuid3Format])(out))
 }
 
 // DeepCopy copies the receiver into a new [UUID]3.
 func (u *UUID3) DeepCopy() *UUID3 {
-	if u == nil {
-		return nil
-	}
-	out := new(UUID3)
-	u.DeepCopyInto(out)
-	return out
+	return (*UUID3)((*StringFormat[uuid3Format])(u).DeepCopy())
 }
 
 // UUID4 represents a uuid4 string format.
@@ -1189,34 +1071,31 @@ func (u *UUID3) DeepCopy() *UUID3 {
 // swagger:strfmt uuid4.
 type UUID4 string
 
+// uuid4Format describes the [UUID4] format.
+type uuid4Format struct{}
+
+func (uuid4Format) FormatName() string { return "uuid4" }
+
+func (uuid4Format) IsValid(s string) bool { return IsUUID4(s) }
+
 // MarshalText turns this instance into text.
 func (u UUID4) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[uuid4Format](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *UUID4) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = UUID4(string(data))
-	return nil
+func (u *UUID4) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[uuid4Format])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *UUID4) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = UUID4(string(v))
-	case string:
-		*u = UUID4(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.UUID4 from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[uuid4Format])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u UUID4) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[uuid4Format](u).Value()
 }
 
 func (u UUID4) String() string {
@@ -1225,35 +1104,22 @@ func (u UUID4) String() string {
 
 // MarshalJSON returns the [UUID4] as JSON.
 func (u UUID4) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[uuid4Format](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [UUID4] from JSON.
 func (u *UUID4) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = UUID4(ustr)
-	return nil
+	return (*StringFormat[uuid4Format])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *UUID4) DeepCopyInto(out *UUID4) {
-	*out = *u
+	(*StringFormat[uuid4Format])(u).DeepCopyInto((*StringFormat[uuid4Format])(out))
 }
 
 // DeepCopy copies the receiver into a new UUID4.
 func (u *UUID4) DeepCopy() *UUID4 {
-	if u == nil {
-		return nil
-	}
-	out := new(UUID4)
-	u.DeepCopyInto(out)
-	return out
+	return (*UUID4)((*StringFormat[uuid4Format])(u).DeepCopy())
 }
 
 // UUID5 represents a uuid5 string format.
@@ -1261,34 +1127,31 @@ func (u *UUID4) DeepCopy() *UUID4 {
 // swagger:strfmt uuid5.
 type UUID5 string
 
+// uuid5Format describes the [UUID5] format.
+type uuid5Format struct{}
+
+func (uuid5Format) FormatName() string { return "uuid5" }
+
+func (uuid5Format) IsValid(s string) bool { return IsUUID5(s) }
+
 // MarshalText turns this instance into text.
 func (u UUID5) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[uuid5Format](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *UUID5) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = UUID5(string(data))
-	return nil
+func (u *UUID5) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[uuid5Format])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *UUID5) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = UUID5(string(v))
-	case string:
-		*u = UUID5(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.UUID5 from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[uuid5Format])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u UUID5) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[uuid5Format](u).Value()
 }
 
 func (u UUID5) String() string {
@@ -1297,35 +1160,22 @@ func (u UUID5) String() string {
 
 // MarshalJSON returns the [UUID5] as JSON.
 func (u UUID5) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[uuid5Format](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [UUID5] from JSON.
 func (u *UUID5) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = UUID5(ustr)
-	return nil
+	return (*StringFormat[uuid5Format])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *UUID5) DeepCopyInto(out *UUID5) {
-	*out = *u
+	(*StringFormat[uuid5Format])(u).DeepCopyInto((*StringFormat[uuid5Format])(out))
 }
 
 // DeepCopy copies the receiver into a new [UUID5].
 func (u *UUID5) DeepCopy() *UUID5 {
-	if u == nil {
-		return nil
-	}
-	out := new(UUID5)
-	u.DeepCopyInto(out)
-	return out
+	return (*UUID5)((*StringFormat[uuid5Format])(u).DeepCopy())
 }
 
 // UUID7 represents a uuid7 string format.
@@ -1333,34 +1183,31 @@ func (u *UUID5) DeepCopy() *UUID5 {
 // swagger:strfmt uuid7.
 type UUID7 string
 
+// uuid7Format describes the [UUID7] format.
+type uuid7Format struct{}
+
+func (uuid7Format) FormatName() string { return "uuid7" }
+
+func (uuid7Format) IsValid(s string) bool { return IsUUID7(s) }
+
 // MarshalText turns this instance into text.
 func (u UUID7) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[uuid7Format](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *UUID7) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = UUID7(string(data))
-	return nil
+func (u *UUID7) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[uuid7Format])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *UUID7) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = UUID7(string(v))
-	case string:
-		*u = UUID7(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.UUID7 from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[uuid7Format])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u UUID7) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[uuid7Format](u).Value()
 }
 
 func (u UUID7) String() string {
@@ -1369,35 +1216,22 @@ func (u UUID7) String() string {
 
 // MarshalJSON returns the [UUID7] as JSON.
 func (u UUID7) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[uuid7Format](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [UUID7] from JSON.
 func (u *UUID7) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = UUID7(ustr)
-	return nil
+	return (*StringFormat[uuid7Format])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *UUID7) DeepCopyInto(out *UUID7) {
-	*out = *u
+	(*StringFormat[uuid7Format])(u).DeepCopyInto((*StringFormat[uuid7Format])(out))
 }
 
 // DeepCopy copies the receiver into a new [UUID]7.
 func (u *UUID7) DeepCopy() *UUID7 {
-	if u == nil {
-		return nil
-	}
-	out := new(UUID7)
-	u.DeepCopyInto(out)
-	return out
+	return (*UUID7)((*StringFormat[uuid7Format])(u).DeepCopy())
 }
 
 // ISBN represents an isbn string format.
@@ -1405,34 +1239,31 @@ func (u *UUID7) DeepCopy() *UUID7 {
 // swagger:strfmt isbn.
 type ISBN string
 
+// isbnFormat describes the [ISBN] format.
+type isbnFormat struct{}
+
+func (isbnFormat) FormatName() string { return "isbn" }
+
+func (isbnFormat) IsValid(s string) bool { return isISBN10(s) || isISBN13(s) }
+
 // MarshalText turns this instance into text.
 func (u ISBN) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[isbnFormat](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *ISBN) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = ISBN(string(data))
-	return nil
+func (u *ISBN) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[isbnFormat])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *ISBN) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = ISBN(string(v))
-	case string:
-		*u = ISBN(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.ISBN from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[isbnFormat])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u ISBN) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[isbnFormat](u).Value()
 }
 
 func (u ISBN) String() string {
@@ -1441,35 +1272,22 @@ func (u ISBN) String() string {
 
 // MarshalJSON returns the [ISBN] as JSON.
 func (u ISBN) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[isbnFormat](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [ISBN] from JSON.
 func (u *ISBN) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = ISBN(ustr)
-	return nil
+	return (*StringFormat[isbnFormat])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *ISBN) DeepCopyInto(out *ISBN) {
-	*out = *u
+	(*StringFormat[isbnFormat])(u).DeepCopyInto((*StringFormat[isbnFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [ISBN].
 func (u *ISBN) DeepCopy() *ISBN {
-	if u == nil {
-		return nil
-	}
-	out := new(ISBN)
-	u.DeepCopyInto(out)
-	return out
+	return (*ISBN)((*StringFormat[isbnFormat])(u).DeepCopy())
 }
 
 // ISBN10 represents an isbn 10 string format.
@@ -1477,34 +1295,31 @@ func (u *ISBN) DeepCopy() *ISBN {
 // swagger:strfmt isbn10.
 type ISBN10 string
 
+// isbn10Format describes the [ISBN10] format.
+type isbn10Format struct{}
+
+func (isbn10Format) FormatName() string { return "isbn10" }
+
+func (isbn10Format) IsValid(s string) bool { return isISBN10(s) }
+
 // MarshalText turns this instance into text.
 func (u ISBN10) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[isbn10Format](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *ISBN10) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = ISBN10(string(data))
-	return nil
+func (u *ISBN10) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[isbn10Format])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *ISBN10) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = ISBN10(string(v))
-	case string:
-		*u = ISBN10(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.ISBN10 from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[isbn10Format])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u ISBN10) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[isbn10Format](u).Value()
 }
 
 func (u ISBN10) String() string {
@@ -1513,35 +1328,22 @@ func (u ISBN10) String() string {
 
 // MarshalJSON returns the [ISBN10] as JSON.
 func (u ISBN10) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[isbn10Format](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [ISBN10] from JSON.
 func (u *ISBN10) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = ISBN10(ustr)
-	return nil
+	return (*StringFormat[isbn10Format])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *ISBN10) DeepCopyInto(out *ISBN10) {
-	*out = *u
+	(*StringFormat[isbn10Format])(u).DeepCopyInto((*StringFormat[isbn10Format])(out))
 }
 
 // DeepCopy copies the receiver into a new [ISBN10].
 func (u *ISBN10) DeepCopy() *ISBN10 {
-	if u == nil {
-		return nil
-	}
-	out := new(ISBN10)
-	u.DeepCopyInto(out)
-	return out
+	return (*ISBN10)((*StringFormat[isbn10Format])(u).DeepCopy())
 }
 
 // ISBN13 represents an isbn 13 string format.
@@ -1549,34 +1351,31 @@ func (u *ISBN10) DeepCopy() *ISBN10 {
 // swagger:strfmt isbn13.
 type ISBN13 string
 
+// isbn13Format describes the [ISBN13] format.
+type isbn13Format struct{}
+
+func (isbn13Format) FormatName() string { return "isbn13" }
+
+func (isbn13Format) IsValid(s string) bool { return isISBN13(s) }
+
 // MarshalText turns this instance into text.
 func (u ISBN13) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[isbn13Format](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *ISBN13) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = ISBN13(string(data))
-	return nil
+func (u *ISBN13) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[isbn13Format])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *ISBN13) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = ISBN13(string(v))
-	case string:
-		*u = ISBN13(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.ISBN13 from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[isbn13Format])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u ISBN13) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[isbn13Format](u).Value()
 }
 
 func (u ISBN13) String() string {
@@ -1585,35 +1384,22 @@ func (u ISBN13) String() string {
 
 // MarshalJSON returns the [ISBN13] as JSON.
 func (u ISBN13) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[isbn13Format](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [ISBN13] from JSON.
 func (u *ISBN13) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = ISBN13(ustr)
-	return nil
+	return (*StringFormat[isbn13Format])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *ISBN13) DeepCopyInto(out *ISBN13) {
-	*out = *u
+	(*StringFormat[isbn13Format])(u).DeepCopyInto((*StringFormat[isbn13Format])(out))
 }
 
 // DeepCopy copies the receiver into a new [ISBN13].
 func (u *ISBN13) DeepCopy() *ISBN13 {
-	if u == nil {
-		return nil
-	}
-	out := new(ISBN13)
-	u.DeepCopyInto(out)
-	return out
+	return (*ISBN13)((*StringFormat[isbn13Format])(u).DeepCopy())
 }
 
 // CreditCard represents a credit card string format.
@@ -1621,34 +1407,33 @@ func (u *ISBN13) DeepCopy() *ISBN13 {
 // swagger:strfmt creditcard.
 type CreditCard string
 
+// creditCardFormat describes the [CreditCard] format.
+type creditCardFormat struct{}
+
+func (creditCardFormat) FormatName() string { return "creditcard" }
+
+func (creditCardFormat) IsValid(s string) bool { return isCreditCard(s) }
+
+func (creditCardFormat) sensitive() {}
+
 // MarshalText turns this instance into text.
 func (u CreditCard) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[creditCardFormat](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *CreditCard) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = CreditCard(string(data))
-	return nil
+func (u *CreditCard) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[creditCardFormat])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *CreditCard) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = CreditCard(string(v))
-	case string:
-		*u = CreditCard(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.CreditCard from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[creditCardFormat])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u CreditCard) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[creditCardFormat](u).Value()
 }
 
 func (u CreditCard) String() string {
@@ -1657,35 +1442,22 @@ func (u CreditCard) String() string {
 
 // MarshalJSON returns the [CreditCard] as JSON.
 func (u CreditCard) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[creditCardFormat](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [CreditCard] from JSON.
 func (u *CreditCard) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = CreditCard(ustr)
-	return nil
+	return (*StringFormat[creditCardFormat])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *CreditCard) DeepCopyInto(out *CreditCard) {
-	*out = *u
+	(*StringFormat[creditCardFormat])(u).DeepCopyInto((*StringFormat[creditCardFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [CreditCard].
 func (u *CreditCard) DeepCopy() *CreditCard {
-	if u == nil {
-		return nil
-	}
-	out := new(CreditCard)
-	u.DeepCopyInto(out)
-	return out
+	return (*CreditCard)((*StringFormat[creditCardFormat])(u).DeepCopy())
 }
 
 // SSN represents a social security string format.
@@ -1693,34 +1465,33 @@ func (u *CreditCard) DeepCopy() *CreditCard {
 // swagger:strfmt ssn.
 type SSN string
 
+// ssnFormat describes the [SSN] format.
+type ssnFormat struct{}
+
+func (ssnFormat) FormatName() string { return "ssn" }
+
+func (ssnFormat) IsValid(s string) bool { return isSSN(s) }
+
+func (ssnFormat) sensitive() {}
+
 // MarshalText turns this instance into text.
 func (u SSN) MarshalText() ([]byte, error) {
-	return []byte(string(u)), nil
+	return StringFormat[ssnFormat](u).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (u *SSN) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = SSN(string(data))
-	return nil
+func (u *SSN) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[ssnFormat])(u).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (u *SSN) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*u = SSN(string(v))
-	case string:
-		*u = SSN(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.SSN from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[ssnFormat])(u).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (u SSN) Value() (driver.Value, error) {
-	return driver.Value(string(u)), nil
+	return StringFormat[ssnFormat](u).Value()
 }
 
 func (u SSN) String() string {
@@ -1729,35 +1500,22 @@ func (u SSN) String() string {
 
 // MarshalJSON returns the [SSN] as JSON.
 func (u SSN) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(u))
+	return StringFormat[ssnFormat](u).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [SSN] from JSON.
 func (u *SSN) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*u = SSN(ustr)
-	return nil
+	return (*StringFormat[ssnFormat])(u).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *SSN) DeepCopyInto(out *SSN) {
-	*out = *u
+	(*StringFormat[ssnFormat])(u).DeepCopyInto((*StringFormat[ssnFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [SSN].
 func (u *SSN) DeepCopy() *SSN {
-	if u == nil {
-		return nil
-	}
-	out := new(SSN)
-	u.DeepCopyInto(out)
-	return out
+	return (*SSN)((*StringFormat[ssnFormat])(u).DeepCopy())
 }
 
 // HexColor represents a hex color string format.
@@ -1765,34 +1523,31 @@ func (u *SSN) DeepCopy() *SSN {
 // swagger:strfmt hexcolor.
 type HexColor string
 
+// hexColorFormat describes the [HexColor] format.
+type hexColorFormat struct{}
+
+func (hexColorFormat) FormatName() string { return "hexcolor" }
+
+func (hexColorFormat) IsValid(s string) bool { return isHexcolor(s) }
+
 // MarshalText turns this instance into text.
 func (h HexColor) MarshalText() ([]byte, error) {
-	return []byte(string(h)), nil
+	return StringFormat[hexColorFormat](h).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (h *HexColor) UnmarshalText(data []byte) error { // validation is performed later on
-	*h = HexColor(string(data))
-	return nil
+func (h *HexColor) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[hexColorFormat])(h).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (h *HexColor) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*h = HexColor(string(v))
-	case string:
-		*h = HexColor(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.HexColor from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[hexColorFormat])(h).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (h HexColor) Value() (driver.Value, error) {
-	return driver.Value(string(h)), nil
+	return StringFormat[hexColorFormat](h).Value()
 }
 
 func (h HexColor) String() string {
@@ -1801,35 +1556,22 @@ func (h HexColor) String() string {
 
 // MarshalJSON returns the [HexColor] as JSON.
 func (h HexColor) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(h))
+	return StringFormat[hexColorFormat](h).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [HexColor] from JSON.
 func (h *HexColor) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*h = HexColor(ustr)
-	return nil
+	return (*StringFormat[hexColorFormat])(h).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (h *HexColor) DeepCopyInto(out *HexColor) {
-	*out = *h
+	(*StringFormat[hexColorFormat])(h).DeepCopyInto((*StringFormat[hexColorFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [HexColor].
 func (h *HexColor) DeepCopy() *HexColor {
-	if h == nil {
-		return nil
-	}
-	out := new(HexColor)
-	h.DeepCopyInto(out)
-	return out
+	return (*HexColor)((*StringFormat[hexColorFormat])(h).DeepCopy())
 }
 
 // RGBColor represents a RGB color string format.
@@ -1837,34 +1579,31 @@ func (h *HexColor) DeepCopy() *HexColor {
 // swagger:strfmt rgbcolor.
 type RGBColor string
 
+// rgbColorFormat describes the [RGBColor] format.
+type rgbColorFormat struct{}
+
+func (rgbColorFormat) FormatName() string { return "rgbcolor" }
+
+func (rgbColorFormat) IsValid(s string) bool { return isRGBcolor(s) }
+
 // MarshalText turns this instance into text.
 func (r RGBColor) MarshalText() ([]byte, error) {
-	return []byte(string(r)), nil
+	return StringFormat[rgbColorFormat](r).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
-func (r *RGBColor) UnmarshalText(data []byte) error { // validation is performed later on
-	*r = RGBColor(string(data))
-	return nil
+func (r *RGBColor) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[rgbColorFormat])(r).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (r *RGBColor) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*r = RGBColor(string(v))
-	case string:
-		*r = RGBColor(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.RGBColor from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[rgbColorFormat])(r).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (r RGBColor) Value() (driver.Value, error) {
-	return driver.Value(string(r)), nil
+	return StringFormat[rgbColorFormat](r).Value()
 }
 
 func (r RGBColor) String() string {
@@ -1873,35 +1612,22 @@ func (r RGBColor) String() string {
 
 // MarshalJSON returns the [RGBColor] as JSON.
 func (r RGBColor) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(r))
+	return StringFormat[rgbColorFormat](r).MarshalJSON()
 }
 
 // UnmarshalJSON sets the [RGBColor] from JSON.
 func (r *RGBColor) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*r = RGBColor(ustr)
-	return nil
+	return (*StringFormat[rgbColorFormat])(r).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (r *RGBColor) DeepCopyInto(out *RGBColor) {
-	*out = *r
+	(*StringFormat[rgbColorFormat])(r).DeepCopyInto((*StringFormat[rgbColorFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new [RGBColor].
 func (r *RGBColor) DeepCopy() *RGBColor {
-	if r == nil {
-		return nil
-	}
-	out := new(RGBColor)
-	r.DeepCopyInto(out)
-	return out
+	return (*RGBColor)((*StringFormat[rgbColorFormat])(r).DeepCopy())
 }
 
 // Password represents a password.
@@ -1910,34 +1636,31 @@ func (r *RGBColor) DeepCopy() *RGBColor {
 // swagger:strfmt password.
 type Password string
 
+// passwordFormat describes the [Password] format.
+type passwordFormat struct{}
+
+func (passwordFormat) FormatName() string { return "password" }
+
+func (passwordFormat) IsValid(_ string) bool { return true }
+
 // MarshalText turns this instance into text.
 func (r Password) MarshalText() ([]byte, error) {
-	return []byte(string(r)), nil
+	return StringFormat[passwordFormat](r).MarshalText()
 }
 
 // UnmarshalText hydrates this instance from text.
 func (r *Password) UnmarshalText(data []byte) error { // validation is performed later on
-	*r = Password(string(data))
-	return nil
+	return (*StringFormat[passwordFormat])(r).UnmarshalText(data)
 }
 
 // Scan read a value from a database driver.
 func (r *Password) Scan(raw any) error {
-	switch v := raw.(type) {
-	case []byte:
-		*r = Password(string(v))
-	case string:
-		*r = Password(v)
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.Password from: %#v: %w", v, ErrFormat)
-	}
-
-	return nil
+	return (*StringFormat[passwordFormat])(r).Scan(raw)
 }
 
 // Value converts a value to a database driver value.
 func (r Password) Value() (driver.Value, error) {
-	return driver.Value(string(r)), nil
+	return StringFormat[passwordFormat](r).Value()
 }
 
 func (r Password) String() string {
@@ -1946,35 +1669,22 @@ func (r Password) String() string {
 
 // MarshalJSON returns the Password as JSON.
 func (r Password) MarshalJSON() ([]byte, error) {
-	return json.Marshal(string(r))
+	return StringFormat[passwordFormat](r).MarshalJSON()
 }
 
 // UnmarshalJSON sets the Password from JSON.
 func (r *Password) UnmarshalJSON(data []byte) error {
-	if string(data) == jsonNull {
-		return nil
-	}
-	var ustr string
-	if err := json.Unmarshal(data, &ustr); err != nil {
-		return err
-	}
-	*r = Password(ustr)
-	return nil
+	return (*StringFormat[passwordFormat])(r).UnmarshalJSON(data)
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
 func (r *Password) DeepCopyInto(out *Password) {
-	*out = *r
+	(*StringFormat[passwordFormat])(r).DeepCopyInto((*StringFormat[passwordFormat])(out))
 }
 
 // DeepCopy copies the receiver into a new Password.
 func (r *Password) DeepCopy() *Password {
-	if r == nil {
-		return nil
-	}
-	out := new(Password)
-	r.DeepCopyInto(out)
-	return out
+	return (*Password)((*StringFormat[passwordFormat])(r).DeepCopy())
 }
 
 func isRequestURI(rawurl string) bool {
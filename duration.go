@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 )
 
 func init() { //nolint:gochecknoinits // registers duration format in the default registry
@@ -98,6 +99,10 @@ func (d Duration) MarshalText() ([]byte, error) {
 
 // UnmarshalText hydrates this instance from text.
 func (d *Duration) UnmarshalText(data []byte) error { // validation is performed later on
+	if len(data) == 0 {
+		*d = 0
+		return nil
+	}
 	dd, err := ParseDuration(string(data))
 	if err != nil {
 		return err
@@ -140,8 +145,15 @@ func (d *Duration) UnmarshalText(data []byte) error { // validation is performed
 // ".5 week",
 // "2 minutes 45 seconds".
 //
-//nolint:gocognit,gocyclo,cyclop // complexity is only slightly above the usual level, may be tolerated as it mimicks the stdlib.
+// See [DurationUnits] to accept other units.
 func ParseDuration(s string) (time.Duration, error) {
+	return parseDurationUnits(s, timeMultiplier)
+}
+
+// parseDurationUnits parses a duration with the given units.
+//
+//nolint:gocognit,gocyclo,cyclop // complexity is only slightly above the usual level, may be tolerated as it mimicks the stdlib.
+func parseDurationUnits(s string, units map[string]uint64) (time.Duration, error) {
 	// NOTE: this code is largely inspired by the standard library.
 	orig := s
 	var d uint64
@@ -208,11 +220,12 @@ func ParseDuration(s string) (time.Duration, error) {
 
 		// Consume unit.
 		i := 0
-		for ; i < len(s); i++ {
-			c := s[i]
-			if c == '.' || '0' <= c && c <= '9' || unicode.IsSpace(rune(c)) {
+		for i < len(s) {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == '.' || '0' <= r && r <= '9' || unicode.IsSpace(r) {
 				break
 			}
+			i += size
 		}
 
 		if i == 0 {
@@ -221,7 +234,7 @@ func ParseDuration(s string) (time.Duration, error) {
 
 		u := s[:i]
 		s = s[i:]
-		unit, ok := timeMultiplier[u]
+		unit, ok := units[u]
 		if !ok {
 			return 0, parseDurationError(orig, fmt.Sprintf("unknown unit %q in duration", u))
 		}
@@ -260,25 +273,59 @@ func ParseDuration(s string) (time.Duration, error) {
 }
 
 // Scan reads a Duration value from database driver type.
+//
+// Integer and float columns are read as a number of [ParseDurationNumericUnit], or as nanoseconds when this
+// setting is disabled.
+//
+// Text columns may use the syntax of [ParseDuration] (e.g. "2h45m"), an ISO 8601 duration (e.g. "P1DT2H")
+// or a Postgres interval (e.g. "1 day 02:03:04"). Months and years are converted like Postgres does:
+// a month lasts 30 days and a year 365.25 days.
 func (d *Duration) Scan(raw any) error {
+	unit := ParseDurationNumericUnit
+	if unit == DurationNumericDisabled {
+		unit = DurationNanos
+	}
+
+	var (
+		dd  time.Duration
+		err error
+	)
 	switch v := raw.(type) {
-	// Proposal for enhancement: case []byte: // ?
 	case int64:
-		*d = Duration(v)
+		dd, err = durationFromInt(v, unit)
 	case float64:
-		*d = Duration(int64(v))
+		dd, err = durationFromFloat(v, unit)
+	case []byte:
+		dd, err = parseDurationText(string(v))
+	case string:
+		dd, err = parseDurationText(v)
 	case nil:
-		*d = Duration(0)
+		dd = 0
 	default:
 		return fmt.Errorf("cannot sql.Scan() strfmt.Duration from: %#v: %w", v, ErrFormat)
 	}
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(dd)
 
 	return nil
 }
 
 // Value converts Duration to a primitive value ready to be written to a database.
+//
+// By default, the value is an int64 number of nanoseconds. See [MarshalDurationNumericUnit]
+// and [MarshalDurationInterval] to write other values.
 func (d Duration) Value() (driver.Value, error) {
-	return driver.Value(int64(d)), nil
+	switch {
+	case MarshalDurationInterval:
+		return driver.Value(formatPostgresInterval(time.Duration(d))), nil
+	case MarshalDurationNumericUnit != DurationNumericDisabled:
+		return driver.Value(int64(time.Duration(d) / MarshalDurationNumericUnit.duration())), nil
+	default:
+		return driver.Value(int64(d)), nil
+	}
 }
 
 // String converts this duration to a string.
@@ -287,26 +334,38 @@ func (d Duration) String() string {
 }
 
 // MarshalJSON returns the Duration as JSON.
+//
+// The duration is a JSON string, or a JSON number when [MarshalDurationNumericUnit] is set.
 func (d Duration) MarshalJSON() ([]byte, error) {
+	if MarshalDurationNumericUnit != DurationNumericDisabled {
+		return []byte(formatDurationNumber(time.Duration(d), MarshalDurationNumericUnit)), nil
+	}
+
 	return json.Marshal(time.Duration(d).String())
 }
 
 // UnmarshalJSON sets the Duration from JSON.
+//
+// JSON numbers are accepted when [ParseDurationNumericUnit] is set.
 func (d *Duration) UnmarshalJSON(data []byte) error {
 	if string(data) == jsonNull {
 		return nil
 	}
 
+	if len(data) > 0 && data[0] != '"' {
+		tt, err := parseDurationNumber(string(data), ParseDurationNumericUnit)
+		if err != nil {
+			return err
+		}
+		*d = Duration(tt)
+		return nil
+	}
+
 	var dstr string
 	if err := json.Unmarshal(data, &dstr); err != nil {
 		return err
 	}
-	tt, err := ParseDuration(dstr)
-	if err != nil {
-		return err
-	}
-	*d = Duration(tt)
-	return nil
+	return d.UnmarshalText([]byte(dstr))
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
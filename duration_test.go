@@ -95,8 +95,8 @@ func TestDurationScanner_Nil(t *testing.T) {
 	require.NoError(t, err)
 	assert.EqualValues(t, 0, time.Duration(result))
 
-	err = result.Scan("1 ms")
-	require.Error(t, err)
+	err = result.Scan(true)
+	require.ErrorIs(t, err, ErrFormat)
 }
 
 func TestDurationParser(t *testing.T) {
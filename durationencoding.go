@@ -0,0 +1,386 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationNumericUnit is the unit of a [Duration] expressed as a number, e.g. 1.5 for 1500ms in seconds.
+//
+// strfmt never guesses the unit of a number from its magnitude: the unit must be set explicitly.
+type DurationNumericUnit uint8
+
+const (
+	// DurationNumericDisabled disables durations expressed as numbers.
+	DurationNumericDisabled DurationNumericUnit = iota
+	// DurationSeconds expresses durations as a number of seconds.
+	DurationSeconds
+	// DurationMillis expresses durations as a number of milliseconds.
+	DurationMillis
+	// DurationMicros expresses durations as a number of microseconds.
+	DurationMicros
+	// DurationNanos expresses durations as a number of nanoseconds.
+	DurationNanos
+)
+
+const (
+	// nominalMonth is the length of a month in a Postgres interval or an ISO 8601 duration, as Postgres counts it.
+	nominalMonth = 30 * hoursInDay * time.Hour
+	// nominalYear is the length of a year in a Postgres interval or an ISO 8601 duration, as Postgres counts it.
+	nominalYear = 8766 * time.Hour // 365.25 days
+)
+
+//nolint:gochecknoglobals // package-level configuration for duration parsing and marshaling
+var (
+	// ParseDurationNumericUnit sets the unit of numbers accepted by [Duration.UnmarshalJSON] (as JSON numbers)
+	// and [Duration.Scan] (as integer or float columns).
+	//
+	// By default, JSON numbers are not accepted, and integer or float columns are read as nanoseconds.
+	ParseDurationNumericUnit = DurationNumericDisabled
+
+	// MarshalDurationNumericUnit sets the unit of numbers produced by [Duration.MarshalJSON] (as a JSON number)
+	// and [Duration.Value] (as an int64, truncated).
+	//
+	// By default, a [Duration] is marshaled to JSON as a string, and written to a database as an int64 number
+	// of nanoseconds. Text marshaling is not affected by this setting.
+	MarshalDurationNumericUnit = DurationNumericDisabled
+
+	// MarshalDurationInterval makes [Duration.Value] write a Postgres interval literal such as "26:03:04.5",
+	// which takes precedence over [MarshalDurationNumericUnit].
+	MarshalDurationInterval = false
+)
+
+// String returns the name of the unit.
+func (u DurationNumericUnit) String() string {
+	switch u {
+	case DurationSeconds:
+		return "seconds"
+	case DurationMillis:
+		return "milliseconds"
+	case DurationMicros:
+		return "microseconds"
+	case DurationNanos:
+		return "nanoseconds"
+	default:
+		return "disabled"
+	}
+}
+
+// duration returns the length of the unit.
+func (u DurationNumericUnit) duration() time.Duration {
+	switch u {
+	case DurationMillis:
+		return time.Millisecond
+	case DurationMicros:
+		return time.Microsecond
+	case DurationNanos:
+		return time.Nanosecond
+	default:
+		return time.Second
+	}
+}
+
+// parseDurationNumber parses a decimal number of units, e.g. "1.5" or "-250".
+//
+// Sub-unit fractions are supported down to the nanosecond. Exponent notations (e.g. "1.5e3") are
+// tolerated, but may lose precision.
+func parseDurationNumber(data string, unit DurationNumericUnit) (time.Duration, error) {
+	if unit == DurationNumericDisabled {
+		return 0, fmt.Errorf("numeric durations are disabled, cannot parse %s: %w", data, ErrFormat)
+	}
+
+	if !isEpoch(data) {
+		f, err := strconv.ParseFloat(data, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid numeric duration %s: %w", data, ErrFormat)
+		}
+
+		return durationFromFloat(f, unit)
+	}
+
+	neg := data[0] == '-'
+	digits := strings.TrimPrefix(data, "-")
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+
+	v, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid numeric duration %s: %w", data, ErrFormat)
+	}
+	d, ok := mulDuration(v, unit.duration())
+	if !ok {
+		return 0, fmt.Errorf("invalid numeric duration %s: numerical overflow: %w", data, ErrFormat)
+	}
+
+	// convert the fraction of a unit into nanoseconds, truncating extra digits
+	for scale := unit.duration() / decimalBase; scale > 0 && fracPart != ""; scale /= decimalBase {
+		d += time.Duration(fracPart[0]-'0') * scale
+		fracPart = fracPart[1:]
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid numeric duration %s: numerical overflow: %w", data, ErrFormat)
+	}
+
+	if neg {
+		return -d, nil
+	}
+
+	return d, nil
+}
+
+// durationFromInt converts an integer number of units.
+func durationFromInt(v int64, unit DurationNumericUnit) (time.Duration, error) {
+	d, ok := mulDuration(v, unit.duration())
+	if !ok {
+		return 0, fmt.Errorf("invalid numeric duration %d %s: numerical overflow: %w", v, unit, ErrFormat)
+	}
+
+	return d, nil
+}
+
+// durationFromFloat converts a floating point number of units.
+func durationFromFloat(f float64, unit DurationNumericUnit) (time.Duration, error) {
+	ns := f * float64(unit.duration())
+	if math.IsNaN(ns) || ns >= math.MaxInt64 || ns <= math.MinInt64 {
+		return 0, fmt.Errorf("invalid numeric duration %v %s: %w", f, unit, ErrFormat)
+	}
+
+	return time.Duration(ns), nil
+}
+
+// formatDurationNumber renders the exact decimal number of units in a duration, e.g. "1.5" for 1500ms in seconds.
+func formatDurationNumber(d time.Duration, unit DurationNumericUnit) string {
+	u := unit.duration()
+	whole, frac := d/u, d%u
+	if frac == 0 {
+		return strconv.FormatInt(int64(whole), decimalBase)
+	}
+
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+		whole, frac = -whole, -frac
+	}
+	b.WriteString(strconv.FormatInt(int64(whole), decimalBase))
+	b.WriteByte('.')
+	width := len(strconv.FormatInt(int64(u), decimalBase)) - 1
+	b.WriteString(strings.TrimRight(fmt.Sprintf("%0*d", width, int64(frac)), "0"))
+
+	return b.String()
+}
+
+// parseDurationText parses a duration read from a database, in any of the following syntaxes:
+//
+//   - the syntax of [ParseDuration], e.g. "2h45m" or "3 days"
+//   - an ISO 8601 duration, e.g. "P1DT2H"
+//   - a Postgres interval, e.g. "1 day 02:03:04"
+//
+// Months and years, found in ISO 8601 durations and Postgres intervals, are converted like Postgres
+// does: a month lasts 30 days and a year 365.25 days. A day always lasts 24 hours.
+func parseDurationText(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if isPeriod(s) {
+		p, err := parsePeriod(s)
+		if err != nil {
+			return 0, err
+		}
+
+		return p.nominal()
+	}
+
+	d, err := ParseDuration(s)
+	if err == nil {
+		return d, nil
+	}
+	if d, ierr := parsePostgresInterval(s); ierr == nil {
+		return d, nil
+	}
+
+	return 0, err
+}
+
+// nominal returns the length of the period, with nominal lengths for calendar components.
+func (p period) nominal() (time.Duration, error) {
+	total := p.clock
+	for _, c := range []struct {
+		n    int
+		unit time.Duration
+	}{
+		{p.years, nominalYear},
+		{p.months, nominalMonth},
+		{p.weeks, daysInWeek * hoursInDay * time.Hour},
+		{p.days, hoursInDay * time.Hour},
+	} {
+		v, ok := mulDuration(int64(c.n), c.unit)
+		if ok {
+			total, ok = addDuration(total, v)
+		}
+		if !ok {
+			return 0, parsePeriodError(p.String(), "numerical overflow")
+		}
+	}
+
+	return total, nil
+}
+
+//nolint:gochecknoglobals // immutable lookup table
+var postgresIntervalUnits = map[string]time.Duration{
+	"year":  nominalYear,
+	"years": nominalYear,
+	"mon":   nominalMonth,
+	"mons":  nominalMonth,
+	"day":   hoursInDay * time.Hour,
+	"days":  hoursInDay * time.Hour,
+}
+
+// parsePostgresInterval parses an interval as output by Postgres with the default "postgres" style,
+// e.g. "1 year 2 mons 3 days 04:05:06.789", "-1 days +02:03:04" or "-00:00:01.5".
+func parsePostgresInterval(s string) (time.Duration, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, parsePostgresIntervalError(s, "empty interval")
+	}
+
+	var total time.Duration
+	for i := 0; i < len(fields); i++ {
+		var (
+			d  time.Duration
+			ok bool
+		)
+
+		if strings.Contains(fields[i], ":") {
+			d, ok = parsePostgresClock(fields[i])
+			if !ok {
+				return 0, parsePostgresIntervalError(s, fmt.Sprintf("invalid time %q", fields[i]))
+			}
+		} else {
+			if i+1 == len(fields) {
+				return 0, parsePostgresIntervalError(s, fmt.Sprintf("missing unit after %q", fields[i]))
+			}
+			n, err := strconv.ParseInt(fields[i], 10, 64)
+			if err != nil {
+				return 0, parsePostgresIntervalError(s, fmt.Sprintf("invalid number %q", fields[i]))
+			}
+			unit, known := postgresIntervalUnits[fields[i+1]]
+			if !known {
+				return 0, parsePostgresIntervalError(s, fmt.Sprintf("unknown unit %q", fields[i+1]))
+			}
+			i++
+			d, ok = mulDuration(n, unit)
+		}
+
+		if ok {
+			total, ok = addDuration(total, d)
+		}
+		if !ok {
+			return 0, parsePostgresIntervalError(s, "numerical overflow")
+		}
+	}
+
+	return total, nil
+}
+
+// parsePostgresClock parses the time part of an interval, e.g. "+02:03:04" or "-100:00:00.000001".
+func parsePostgresClock(s string) (time.Duration, bool) {
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	hh, rest, _ := strings.Cut(s, ":")
+	mm, ss, found := strings.Cut(rest, ":")
+	if !found || len(mm) != 2 || len(ss) < 2 || (len(ss) > 2 && ss[2] != '.') {
+		return 0, false
+	}
+
+	h, okH := atoiDigits(hh)
+	m, okM := atoiDigits(mm)
+	sec, okS := atoiDigits(ss[:2])
+	if !okH || !okM || !okS || m > lastMinuteOfHour || sec >= secondsPerMinute {
+		return 0, false
+	}
+
+	d, ok := mulDuration(int64(h), time.Hour)
+	if !ok {
+		return 0, false
+	}
+	d += time.Duration(m)*time.Minute + time.Duration(sec)*time.Second
+
+	if len(ss) > 2 {
+		frac := ss[3:]
+		if frac == "" || len(frac) > maxFractionalDigits {
+			return 0, false
+		}
+		for scale := time.Second / decimalBase; frac != ""; scale /= decimalBase {
+			if !isASCIIDigit(frac[0]) {
+				return 0, false
+			}
+			d += time.Duration(frac[0]-'0') * scale
+			frac = frac[1:]
+		}
+	}
+	if d < 0 {
+		return 0, false
+	}
+
+	if neg {
+		return -d, true
+	}
+
+	return d, true
+}
+
+// formatPostgresInterval renders a duration as a Postgres interval literal, e.g. "26:03:04.5".
+//
+// Days are not used, since a Postgres day is a calendar day, which may not last 24 hours.
+func formatPostgresInterval(d time.Duration) string {
+	var b strings.Builder
+	abs := uint64(d) //nolint:gosec // the sign is handled below
+	if d < 0 {
+		b.WriteByte('-')
+		abs = uint64(-d) //nolint:gosec // the minimum duration negates to itself, which converts to its magnitude
+	}
+
+	fmt.Fprintf(&b, "%02d:%02d:%02d", abs/hours, abs%hours/minutes, abs%minutes/seconds)
+	if frac := abs % seconds; frac != 0 {
+		b.WriteByte('.')
+		b.WriteString(strings.TrimRight(fmt.Sprintf("%09d", frac), "0"))
+	}
+
+	return b.String()
+}
+
+func parsePostgresIntervalError(s, msg string) error {
+	return fmt.Errorf("invalid interval: %s: %s: %w", s, msg, ErrFormat)
+}
+
+// mulDuration returns n*unit, and false on overflow.
+func mulDuration(n int64, unit time.Duration) (time.Duration, bool) {
+	if n == 0 {
+		return 0, true
+	}
+	d := time.Duration(n) * unit
+	if d/unit != time.Duration(n) {
+		return 0, false
+	}
+
+	return d, true
+}
+
+// addDuration returns a+b, and false on overflow.
+func addDuration(a, b time.Duration) (time.Duration, bool) {
+	s := a + b
+	if (a > 0 && b > 0 && s < 0) || (a < 0 && b < 0 && s >= 0) {
+		return 0, false
+	}
+
+	return s, true
+}
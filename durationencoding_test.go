@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func withDurationEncoding(t *testing.T, parse, marshal DurationNumericUnit, interval bool) {
+	t.Helper()
+
+	oldParse, oldMarshal, oldInterval := ParseDurationNumericUnit, MarshalDurationNumericUnit, MarshalDurationInterval
+	t.Cleanup(func() {
+		ParseDurationNumericUnit, MarshalDurationNumericUnit, MarshalDurationInterval = oldParse, oldMarshal, oldInterval
+	})
+	ParseDurationNumericUnit, MarshalDurationNumericUnit, MarshalDurationInterval = parse, marshal, interval
+}
+
+func TestDuration_JSONNumber(t *testing.T) {
+	t.Run("should not accept numbers by default", func(t *testing.T) {
+		var d Duration
+		require.ErrorIs(t, d.UnmarshalJSON([]byte(`1.5`)), ErrFormat)
+
+		b, err := Duration(time.Second).MarshalJSON()
+		require.NoError(t, err)
+		assert.EqualT(t, `"1s"`, string(b))
+	})
+
+	for _, tc := range []struct {
+		unit     DurationNumericUnit
+		input    string
+		expected time.Duration
+	}{
+		{DurationSeconds, "1", time.Second},
+		{DurationSeconds, "1.5", 1500 * time.Millisecond},
+		{DurationSeconds, "-0.25", -250 * time.Millisecond},
+		{DurationSeconds, "1.0000000019", time.Second + time.Nanosecond},
+		{DurationSeconds, "1.5e3", 1500 * time.Second},
+		{DurationMillis, "250", 250 * time.Millisecond},
+		{DurationMillis, "0.5", 500 * time.Microsecond},
+		{DurationMicros, "3", 3 * time.Microsecond},
+		{DurationNanos, "42", 42},
+	} {
+		t.Run(tc.unit.String()+" "+tc.input, func(t *testing.T) {
+			withDurationEncoding(t, tc.unit, DurationNumericDisabled, false)
+
+			var d Duration
+			require.NoError(t, json.Unmarshal([]byte(tc.input), &d))
+			assert.EqualT(t, tc.expected, time.Duration(d))
+
+			require.NoError(t, json.Unmarshal([]byte(`"2h"`), &d))
+			assert.EqualT(t, 2*time.Hour, time.Duration(d))
+		})
+	}
+
+	t.Run("should reject invalid numbers", func(t *testing.T) {
+		withDurationEncoding(t, DurationSeconds, DurationNumericDisabled, false)
+
+		var d Duration
+		for _, input := range []string{`1e300`, `99999999999999999999`, `true`, `[1]`} {
+			require.Errorf(t, d.UnmarshalJSON([]byte(input)), "input: %s", input)
+		}
+	})
+
+	for _, tc := range []struct {
+		unit     DurationNumericUnit
+		duration time.Duration
+		expected string
+	}{
+		{DurationSeconds, 90 * time.Second, "90"},
+		{DurationSeconds, 1500 * time.Millisecond, "1.5"},
+		{DurationSeconds, -time.Nanosecond, "-0.000000001"},
+		{DurationMillis, 1500 * time.Microsecond, "1.5"},
+		{DurationNanos, time.Second, "1000000000"},
+	} {
+		t.Run("marshal "+tc.expected, func(t *testing.T) {
+			withDurationEncoding(t, tc.unit, tc.unit, false)
+
+			b, err := json.Marshal(Duration(tc.duration))
+			require.NoError(t, err)
+			assert.EqualT(t, tc.expected, string(b))
+
+			var d Duration
+			require.NoError(t, json.Unmarshal(b, &d))
+			assert.EqualT(t, tc.duration, time.Duration(d))
+
+			b, err = Duration(tc.duration).MarshalText()
+			require.NoError(t, err)
+			assert.EqualT(t, tc.duration.String(), string(b))
+		})
+	}
+}
+
+func TestDuration_ScanText(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected time.Duration
+	}{
+		{"2h45m", 2*time.Hour + 45*time.Minute},
+		{"1 ms", time.Millisecond},
+		{"3 days", 72 * time.Hour},
+		{"PT1H30M", 90 * time.Minute},
+		{"P1DT0.5S", 24*time.Hour + 500*time.Millisecond},
+		{"P1M", 30 * 24 * time.Hour},
+		{"P1Y", 8766 * time.Hour},
+		{"P2W", 14 * 24 * time.Hour},
+		{"00:00:00", 0},
+		{"00:00:01", time.Second},
+		{"1 day 02:03:04", 26*time.Hour + 3*time.Minute + 4*time.Second},
+		{"1 day", 24 * time.Hour},
+		{"-1 days +02:03:04", -22*time.Hour + 3*time.Minute + 4*time.Second},
+		{"-00:00:01.5", -1500 * time.Millisecond},
+		{"100:00:00.000001", 100*time.Hour + time.Microsecond},
+		{"1 year 2 mons 3 days 04:05:06.789", 8766*time.Hour + 63*24*time.Hour + 4*time.Hour + 5*time.Minute + 6789*time.Millisecond},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			for _, value := range []any{tc.input, []byte(tc.input)} {
+				var d Duration
+				require.NoError(t, d.Scan(value))
+				assert.EqualT(t, tc.expected, time.Duration(d))
+			}
+		})
+	}
+
+	for _, input := range []string{
+		"yesterday",
+		"1 fortnight",
+		"1 day 02:03",
+		"1 day 02:60:00",
+		"1 day 02:03:04.",
+		"1 day 02:03:04.1234567891",
+		"1 days 2",
+		"1 week 02:03:04",
+		"P1.5D",
+		"99999999 years",
+		"9999999999:00:00",
+	} {
+		var d Duration
+		require.ErrorIsf(t, d.Scan(input), ErrFormat, "input: %q", input)
+	}
+}
+
+func TestDuration_ScanNumber(t *testing.T) {
+	t.Run("should read nanoseconds by default", func(t *testing.T) {
+		var d Duration
+		require.NoError(t, d.Scan(int64(1500)))
+		assert.EqualT(t, 1500*time.Nanosecond, time.Duration(d))
+	})
+
+	t.Run("should read the configured unit", func(t *testing.T) {
+		withDurationEncoding(t, DurationMillis, DurationNumericDisabled, false)
+
+		var d Duration
+		require.NoError(t, d.Scan(int64(1500)))
+		assert.EqualT(t, 1500*time.Millisecond, time.Duration(d))
+
+		require.NoError(t, d.Scan(float64(0.5)))
+		assert.EqualT(t, 500*time.Microsecond, time.Duration(d))
+
+		require.ErrorIs(t, d.Scan(int64(1<<62)), ErrFormat)
+	})
+}
+
+func TestDuration_Value(t *testing.T) {
+	d := Duration(26*time.Hour + 3*time.Minute + 4*time.Second + 500*time.Millisecond)
+
+	t.Run("should write nanoseconds by default", func(t *testing.T) {
+		v, err := d.Value()
+		require.NoError(t, err)
+		assert.EqualValues(t, int64(d), v)
+	})
+
+	t.Run("should write the configured unit", func(t *testing.T) {
+		withDurationEncoding(t, DurationNumericDisabled, DurationSeconds, false)
+
+		v, err := d.Value()
+		require.NoError(t, err)
+		assert.EqualValues(t, int64(93784), v)
+	})
+
+	t.Run("should write an interval literal", func(t *testing.T) {
+		withDurationEncoding(t, DurationNumericDisabled, DurationSeconds, true)
+
+		for _, tc := range []struct {
+			duration Duration
+			expected string
+		}{
+			{d, "26:03:04.5"},
+			{-d, "-26:03:04.5"},
+			{0, "00:00:00"},
+			{Duration(time.Nanosecond), "00:00:00.000000001"},
+		} {
+			v, err := tc.duration.Value()
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.expected, v)
+
+			var back Duration
+			require.NoError(t, back.Scan(v))
+			assert.EqualT(t, tc.duration, back)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// DurationUnits is a set of units accepted when parsing a [Duration].
+//
+// A new set holds the units known to [ParseDuration]: more units, or localized aliases, may be added to it.
+// Since a set is not shared, additions to one set do not affect [ParseDuration] or other sets.
+//
+// A set may be attached to a registry (see [WithDurationUnits]), so that the registry parses and validates
+// the "duration" format with these units.
+//
+// The methods of [Duration] (e.g. UnmarshalJSON) have no such context: they only accept the units known to [ParseDuration].
+//
+// A [DurationUnits] is safe for concurrent use.
+type DurationUnits struct {
+	mx    sync.RWMutex
+	units map[string]uint64
+}
+
+// NewDurationUnits creates a new set of duration units, seeded with the units known to [ParseDuration].
+func NewDurationUnits() *DurationUnits {
+	return &DurationUnits{units: maps.Clone(timeMultiplier)}
+}
+
+// Add registers aliases for a unit, e.g.
+//
+//	units.Add(14*24*time.Hour, "fortnight", "fortnights")
+//	units.Add(time.Hour, "heure", "heures", "Stunde", "Stunden")
+//
+// An alias which is already known is replaced. Aliases are case-sensitive.
+//
+// An alias must not be empty, and may not contain blank spaces, digits or dots,
+// which delimit the numerical values of a duration.
+func (u *DurationUnits) Add(unit time.Duration, aliases ...string) error {
+	if unit <= 0 {
+		return fmt.Errorf("invalid duration unit %v: a unit must be positive: %w", unit, ErrFormat)
+	}
+	for _, alias := range aliases {
+		if !isDurationUnitAlias(alias) {
+			return fmt.Errorf("invalid duration unit alias %q: %w", alias, ErrFormat)
+		}
+	}
+
+	u.mx.Lock()
+	defer u.mx.Unlock()
+
+	for _, alias := range aliases {
+		u.units[alias] = uint64(unit)
+	}
+
+	return nil
+}
+
+// Lookup returns the unit known by an alias, e.g. [time.Hour] for "hours".
+func (u *DurationUnits) Lookup(alias string) (time.Duration, bool) {
+	if u == nil {
+		unit, ok := timeMultiplier[alias]
+		return time.Duration(unit), ok //nolint:gosec // units are bounded by construction
+	}
+
+	u.mx.RLock()
+	defer u.mx.RUnlock()
+
+	unit, ok := u.units[alias]
+
+	return time.Duration(unit), ok //nolint:gosec // units are bounded by construction
+}
+
+// ParseDuration parses a duration like [ParseDuration] does, accepting the units of this set.
+//
+// A nil set stands for the units known to [ParseDuration].
+func (u *DurationUnits) ParseDuration(s string) (time.Duration, error) {
+	if u == nil {
+		return ParseDuration(s)
+	}
+
+	u.mx.RLock()
+	defer u.mx.RUnlock()
+
+	return parseDurationUnits(s, u.units)
+}
+
+// IsDuration returns true if the provided string is a valid duration with the units of this set.
+func (u *DurationUnits) IsDuration(str string) bool {
+	_, err := u.ParseDuration(str)
+	return err == nil
+}
+
+func isDurationUnitAlias(alias string) bool {
+	return alias != "" && !strings.ContainsFunc(alias, func(r rune) bool {
+		return r == '.' || ('0' <= r && r <= '9') || unicode.IsSpace(r)
+	})
+}
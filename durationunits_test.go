@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestDurationUnits(t *testing.T) {
+	units := NewDurationUnits()
+	require.NoError(t, units.Add(14*24*time.Hour, "fortnight", "fortnights"))
+	require.NoError(t, units.Add(8*time.Hour, "shift", "shifts"))
+	require.NoError(t, units.Add(24*time.Hour, "jour", "jours"))
+	require.NoError(t, units.Add(time.Hour, "Stunde", "Stunden"))
+	require.NoError(t, units.Add(time.Minute, "minuto", "minutos"))
+	require.NoError(t, units.Add(time.Second, "à", "секунд"))
+
+	for _, tc := range []struct {
+		input    string
+		expected time.Duration
+	}{
+		{"1 fortnight", 14 * 24 * time.Hour},
+		{"2 shifts 30 minutes", 16*time.Hour + 30*time.Minute},
+		{"1.5 jours", 36 * time.Hour},
+		{"3 Stunden 15 minutos", 3*time.Hour + 15*time.Minute},
+		{"5à", 5 * time.Second},
+		{"10 секунд", 10 * time.Second},
+		{"2h45m", 2*time.Hour + 45*time.Minute},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			d, err := units.ParseDuration(tc.input)
+			require.NoError(t, err)
+			assert.EqualT(t, tc.expected, d)
+			assert.TrueT(t, units.IsDuration(tc.input))
+		})
+	}
+
+	unit, ok := units.Lookup("shift")
+	require.TrueT(t, ok)
+	assert.EqualT(t, 8*time.Hour, unit)
+
+	t.Run("should not leak into other sets", func(t *testing.T) {
+		assert.FalseT(t, IsDuration("1 fortnight"))
+		assert.FalseT(t, NewDurationUnits().IsDuration("1 fortnight"))
+
+		var builtin *DurationUnits
+		assert.FalseT(t, builtin.IsDuration("1 fortnight"))
+		unit, ok := builtin.Lookup("hours")
+		require.TrueT(t, ok)
+		assert.EqualT(t, time.Hour, unit)
+	})
+
+	t.Run("should replace an existing alias", func(t *testing.T) {
+		units := NewDurationUnits()
+		require.NoError(t, units.Add(30*24*time.Hour, "m"))
+		d, err := units.ParseDuration("2m")
+		require.NoError(t, err)
+		assert.EqualT(t, 60*24*time.Hour, d)
+	})
+
+	t.Run("should reject invalid units", func(t *testing.T) {
+		require.ErrorIs(t, units.Add(0, "never"), ErrFormat)
+		require.ErrorIs(t, units.Add(-time.Hour, "never"), ErrFormat)
+		for _, alias := range []string{"", "two words", "h2", "h.", "nbsp\u00a0"} {
+			require.ErrorIsf(t, units.Add(time.Hour, alias), ErrFormat, "alias: %q", alias)
+		}
+		_, ok := units.Lookup("never")
+		assert.FalseT(t, ok)
+	})
+}
+
+func TestDurationUnits_Registry(t *testing.T) {
+	units := NewDurationUnits()
+	require.NoError(t, units.Add(8*time.Hour, "shift", "shifts"))
+
+	registry := NewFormats(WithDurationUnits(units))
+	assert.EqualT(t, units, DurationUnitsOf(registry))
+	assert.Nil(t, DurationUnitsOf(NewFormats()))
+
+	assert.TrueT(t, registry.Validates("duration", "2 shifts"))
+	assert.FalseT(t, registry.Validates("duration", "2 watches"))
+	assert.FalseT(t, Default.Validates("duration", "2 shifts"))
+
+	v, err := registry.Parse("duration", "2 shifts")
+	require.NoError(t, err)
+	assert.EqualT(t, Duration(16*time.Hour), *(v.(*Duration)))
+
+	_, err = Default.Parse("duration", "2 shifts")
+	require.ErrorIs(t, err, ErrFormat)
+
+	decoded, err := registry.(*defaultFormats).decodeFormatFromString("duration", reflect.TypeFor[Duration](), "1 shift")
+	require.NoError(t, err)
+	assert.EqualT(t, Duration(8*time.Hour), decoded.(Duration))
+}
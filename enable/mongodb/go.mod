@@ -7,4 +7,13 @@ require (
 	go.mongodb.org/mongo-driver/v2 v2.5.1
 )
 
+require (
+	github.com/go-openapi/errors v0.22.7 // indirect
+	github.com/go-viper/mapstructure/v2 v2.5.0 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/oklog/ulid/v2 v2.1.1 // indirect
+	golang.org/x/net v0.53.0 // indirect
+	golang.org/x/text v0.36.0 // indirect
+)
+
 replace github.com/go-openapi/strfmt => ../..
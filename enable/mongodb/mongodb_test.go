@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodb_test
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	_ "github.com/go-openapi/strfmt/enable/mongodb"
+	"github.com/go-openapi/strfmt/strfmttest"
+)
+
+// TestDriverCodec checks that the formats encoded as BSON by the driver codec decode like with the minimal codec.
+func TestDriverCodec(t *testing.T) {
+	t.Run("email", func(t *testing.T) {
+		strfmttest.Run[strfmt.Email](t, strfmttest.Case{Name: "email", Valid: []string{"somebody@example.com"}})
+	})
+	t.Run("date", func(t *testing.T) {
+		strfmttest.Run[strfmt.Date](t, strfmttest.Case{Name: "date", Valid: []string{"2014-12-15"}})
+	})
+	t.Run("datetime", func(t *testing.T) {
+		strfmttest.Run[strfmt.DateTime](t, strfmttest.Case{Name: "datetime", Valid: []string{"2012-03-02T15:06:05.999Z"}})
+	})
+	t.Run("duration", func(t *testing.T) {
+		strfmttest.Run[strfmt.Duration](t, strfmttest.Case{Name: "duration", Valid: []string{"5s"}})
+	})
+	t.Run("ulid", func(t *testing.T) {
+		strfmttest.Run[strfmt.ULID](t, strfmttest.Case{Name: "ulid", Valid: []string{"01EYXZVGBHG26MFTG4JWR4K558"}})
+	})
+	t.Run("objectid", func(t *testing.T) {
+		strfmttest.Run[strfmt.ObjectId](t, strfmttest.Case{Name: "bsonobjectid", Valid: []string{"507f1f77bcf86cd799439011"}})
+	})
+	t.Run("interval", func(t *testing.T) {
+		strfmttest.Run[strfmt.Interval](t, strfmttest.Case{Name: "interval", Valid: []string{"2024-01-01/P1M"}})
+	})
+	t.Run("civildate", func(t *testing.T) {
+		strfmttest.Run[strfmt.CivilDate](t, strfmttest.Case{Valid: []string{"2024-02-29"}})
+	})
+}
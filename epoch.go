@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// EpochUnit is the unit of a unix epoch timestamp.
+//
+// strfmt never guesses the unit of a timestamp from its magnitude: the unit must be set explicitly.
+type EpochUnit uint8
+
+const (
+	// EpochDefault stands for the package-level setting, in a [TimeConfig].
+	// Used as a package-level setting, it disables unix epoch timestamps.
+	EpochDefault EpochUnit = iota
+	// EpochDisabled disables unix epoch timestamps.
+	EpochDisabled
+	// EpochSeconds stands for seconds elapsed since January 1, 1970 UTC.
+	EpochSeconds
+	// EpochMillis stands for milliseconds elapsed since January 1, 1970 UTC.
+	EpochMillis
+	// EpochMicros stands for microseconds elapsed since January 1, 1970 UTC.
+	EpochMicros
+	// EpochNanos stands for nanoseconds elapsed since January 1, 1970 UTC.
+	EpochNanos
+)
+
+//nolint:gochecknoglobals // package-level configuration for datetime parsing and marshaling
+var (
+	// ParseEpochUnit sets the unit of unix epoch timestamps accepted by [ParseDateTime], [DateTime.UnmarshalJSON]
+	// (as JSON numbers) and [DateTime.Scan] (as integer or float columns).
+	//
+	// By default, unix epoch timestamps are not accepted.
+	ParseEpochUnit = EpochDisabled
+
+	// MarshalEpochUnit sets the unit of unix epoch timestamps produced by [DateTime.MarshalJSON] (as a JSON number)
+	// and [DateTime.Value] (as an int64).
+	//
+	// By default, a [DateTime] is marshaled as a string using [MarshalFormat].
+	// Text marshaling is not affected by this setting.
+	MarshalEpochUnit = EpochDisabled
+)
+
+// String returns the name of the unit.
+func (u EpochUnit) String() string {
+	switch u {
+	case EpochSeconds:
+		return "seconds"
+	case EpochMillis:
+		return "milliseconds"
+	case EpochMicros:
+		return "microseconds"
+	case EpochNanos:
+		return "nanoseconds"
+	default:
+		return "disabled"
+	}
+}
+
+// enabled tells if unix epoch timestamps are enabled with this unit.
+func (u EpochUnit) enabled() bool {
+	return u > EpochDisabled
+}
+
+// perSecond returns the number of units in one second.
+func (u EpochUnit) perSecond() int64 {
+	switch u {
+	case EpochMillis:
+		return int64(time.Second / time.Millisecond)
+	case EpochMicros:
+		return int64(time.Second / time.Microsecond)
+	case EpochNanos:
+		return int64(time.Second)
+	default:
+		return 1
+	}
+}
+
+// isEpoch tells if the string looks like a decimal unix epoch timestamp, e.g. "1700000000" or "-1.5".
+func isEpoch(data string) bool {
+	if data != "" && data[0] == '-' {
+		data = data[1:]
+	}
+
+	digits, dot := 0, false
+	for i := range len(data) {
+		switch c := data[i]; {
+		case isASCIIDigit(c):
+			digits++
+		case c == '.' && !dot && digits > 0:
+			dot = true
+		default:
+			return false
+		}
+	}
+
+	return digits > 0 && data[len(data)-1] != '.'
+}
+
+// parseEpoch parses a decimal unix epoch timestamp expressed in the given unit.
+//
+// Sub-unit fractions are supported down to the nanosecond. Exponent notations (e.g. "1.7e9") are
+// tolerated, but may lose precision.
+func parseEpoch(data string, unit EpochUnit, loc *time.Location) (time.Time, error) {
+	if !unit.enabled() {
+		return time.Time{}, fmt.Errorf("unix epoch timestamps are disabled, cannot parse %q: %w", data, ErrFormat)
+	}
+
+	if !isEpoch(data) {
+		f, err := strconv.ParseFloat(data, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid unix epoch timestamp %q: %w", data, ErrFormat)
+		}
+
+		return epochFromFloat(f, unit, loc)
+	}
+
+	neg := data[0] == '-'
+	if neg {
+		data = data[1:]
+	}
+
+	intPart, fracPart := data, ""
+	for i := range len(data) {
+		if data[i] == '.' {
+			intPart, fracPart = data[:i], data[i+1:]
+			break
+		}
+	}
+
+	v, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid unix epoch timestamp %q: %w", data, ErrFormat)
+	}
+
+	// convert the fraction of a unit into nanoseconds, truncating extra digits
+	perSecond := unit.perSecond()
+	nsPerUnit := int64(time.Second) / perSecond
+	var frac int64
+	for scale := nsPerUnit / decimalBase; scale > 0 && fracPart != ""; scale /= decimalBase {
+		frac += int64(fracPart[0]-'0') * scale
+		fracPart = fracPart[1:]
+	}
+
+	sec, rem := v/perSecond, v%perSecond
+	nsec := rem*nsPerUnit + frac
+	if neg {
+		sec, nsec = -sec, -nsec
+	}
+
+	return time.Unix(sec, nsec).In(loc), nil
+}
+
+// epochFromInt converts an integer unix epoch timestamp expressed in the given unit.
+func epochFromInt(v int64, unit EpochUnit, loc *time.Location) (time.Time, error) {
+	if !unit.enabled() {
+		return time.Time{}, fmt.Errorf("unix epoch timestamps are disabled, cannot convert %d: %w", v, ErrFormat)
+	}
+
+	perSecond := unit.perSecond()
+
+	return time.Unix(v/perSecond, (v%perSecond)*(int64(time.Second)/perSecond)).In(loc), nil
+}
+
+// epochFromFloat converts a floating point unix epoch timestamp expressed in the given unit.
+func epochFromFloat(f float64, unit EpochUnit, loc *time.Location) (time.Time, error) {
+	if !unit.enabled() {
+		return time.Time{}, fmt.Errorf("unix epoch timestamps are disabled, cannot convert %v: %w", f, ErrFormat)
+	}
+
+	sec := f / float64(unit.perSecond())
+	if math.IsNaN(sec) || math.IsInf(sec, 0) || sec >= math.MaxInt64 || sec <= math.MinInt64 {
+		return time.Time{}, fmt.Errorf("invalid unix epoch timestamp %v: %w", f, ErrFormat)
+	}
+	whole, frac := math.Modf(sec)
+
+	return time.Unix(int64(whole), int64(frac*float64(time.Second))).In(loc), nil
+}
+
+// epochOf returns the unix epoch timestamp of t in the given unit, truncated to an integer.
+func epochOf(t time.Time, unit EpochUnit) int64 {
+	switch unit {
+	case EpochMillis:
+		return t.UnixMilli()
+	case EpochMicros:
+		return t.UnixMicro()
+	case EpochNanos:
+		return t.UnixNano()
+	default:
+		return t.Unix()
+	}
+}
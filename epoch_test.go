@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func withEpochUnits(t *testing.T, parse, marshal EpochUnit) {
+	t.Helper()
+
+	oldParse, oldMarshal := ParseEpochUnit, MarshalEpochUnit
+	t.Cleanup(func() {
+		ParseEpochUnit, MarshalEpochUnit = oldParse, oldMarshal
+	})
+	ParseEpochUnit, MarshalEpochUnit = parse, marshal
+}
+
+func TestParseDateTime_Epoch(t *testing.T) {
+	t.Run("should not parse epochs by default", func(t *testing.T) {
+		_, err := ParseDateTime("1700000000")
+		require.Error(t, err)
+	})
+
+	for _, tc := range []struct {
+		unit     EpochUnit
+		input    string
+		expected time.Time
+	}{
+		{EpochSeconds, "1700000000", time.Unix(1700000000, 0)},
+		{EpochSeconds, "1700000000.25", time.Unix(1700000000, 250_000_000)},
+		{EpochSeconds, "-1.5", time.Unix(-1, -500_000_000)},
+		{EpochSeconds, "0", time.Unix(0, 0)},
+		{EpochMillis, "1700000000123", time.Unix(1700000000, 123_000_000)},
+		{EpochMillis, "1700000000123.5", time.Unix(1700000000, 123_500_000)},
+		{EpochMillis, "-1500", time.Unix(-1, -500_000_000)},
+		{EpochMicros, "1700000000123456", time.Unix(1700000000, 123_456_000)},
+		{EpochNanos, "1700000000123456789", time.Unix(1700000000, 123_456_789)},
+		{EpochNanos, "1700000000123456789.9", time.Unix(1700000000, 123_456_789)},
+	} {
+		t.Run(tc.unit.String()+"/"+tc.input, func(t *testing.T) {
+			withEpochUnits(t, tc.unit, EpochDisabled)
+
+			dt, err := ParseDateTime(tc.input)
+			require.NoError(t, err)
+			assert.TrueT(t, tc.expected.Equal(time.Time(dt)))
+			assert.EqualT(t, DefaultTimeLocation, time.Time(dt).Location())
+		})
+	}
+
+	t.Run("should still parse RFC 3339 date-times", func(t *testing.T) {
+		withEpochUnits(t, EpochSeconds, EpochDisabled)
+
+		dt, err := ParseDateTime("2023-11-14T22:13:20Z")
+		require.NoError(t, err)
+		assert.EqualT(t, int64(1700000000), time.Time(dt).Unix())
+	})
+
+	t.Run("should reject malformed epochs", func(t *testing.T) {
+		withEpochUnits(t, EpochSeconds, EpochDisabled)
+
+		for _, input := range []string{"1.", ".5", "-", "1e", "99999999999999999999"} {
+			_, err := ParseDateTime(input)
+			require.Errorf(t, err, "expected %q to fail", input)
+		}
+	})
+}
+
+func TestDateTime_Epoch_JSON(t *testing.T) {
+	t.Run("should reject JSON numbers by default", func(t *testing.T) {
+		var dt DateTime
+		require.ErrorIs(t, json.Unmarshal([]byte(`1700000000`), &dt), ErrFormat)
+	})
+
+	t.Run("should unmarshal JSON numbers and strings", func(t *testing.T) {
+		withEpochUnits(t, EpochMillis, EpochDisabled)
+
+		for _, input := range []string{`1700000000123`, `"1700000000123"`} {
+			var dt DateTime
+			require.NoError(t, json.Unmarshal([]byte(input), &dt))
+			assert.EqualT(t, int64(1700000000123), time.Time(dt).UnixMilli())
+		}
+
+		var dt DateTime
+		require.NoError(t, json.Unmarshal([]byte(`1.7e12`), &dt))
+		assert.EqualT(t, int64(1700000000000), time.Time(dt).UnixMilli())
+
+		require.Error(t, json.Unmarshal([]byte(`true`), &dt))
+	})
+
+	t.Run("should marshal as a JSON number", func(t *testing.T) {
+		withEpochUnits(t, EpochDisabled, EpochSeconds)
+
+		dt := DateTime(time.Unix(1700000000, 999_000_000))
+		assert.JSONMarshalAsT(t, `1700000000`, dt)
+
+		// text marshaling is not affected
+		txt, err := dt.MarshalText()
+		require.NoError(t, err)
+		assert.EqualT(t, "2023-11-14T22:13:20.999Z", string(txt))
+	})
+
+	t.Run("should round-trip", func(t *testing.T) {
+		withEpochUnits(t, EpochNanos, EpochNanos)
+
+		dt := DateTime(time.Unix(1700000000, 123_456_789).UTC())
+		b, err := json.Marshal(dt)
+		require.NoError(t, err)
+
+		var back DateTime
+		require.NoError(t, json.Unmarshal(b, &back))
+		assert.TrueT(t, time.Time(dt).Equal(time.Time(back)))
+	})
+}
+
+func TestDateTime_Epoch_SQL(t *testing.T) {
+	withEpochUnits(t, EpochSeconds, EpochMillis)
+
+	var dt DateTime
+	require.NoError(t, dt.Scan(int64(1700000000)))
+	assert.EqualT(t, int64(1700000000), time.Time(dt).Unix())
+
+	require.NoError(t, dt.Scan(float64(1700000000.5)))
+	assert.EqualT(t, int64(1700000000500), time.Time(dt).UnixMilli())
+
+	require.NoError(t, dt.Scan("1700000001"))
+	assert.EqualT(t, int64(1700000001), time.Time(dt).Unix())
+
+	v, err := dt.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700000001000), v)
+
+	require.ErrorIs(t, dt.Scan(float64(1e300)), ErrFormat)
+}
@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/subtle"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// Semantic equality and ordering.
+//
+// Formats based on a string are equal when they have the same canonical form (see [UUID.Canonical]).
+// Invalid values are only equal to the very same string.
+//
+// Compare methods return -1, 0 or +1 and are suitable for [slices.SortFunc].
+
+// Equal checks if two [URI] instances are equal, regardless of the case of their scheme and host.
+func (u URI) Equal(other URI) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [Email] instances have the same address, regardless of the case of the domain
+// and of the display name.
+func (e Email) Equal(other Email) bool {
+	return equalCanonical(e, other)
+}
+
+// Equal checks if two [Hostname] instances are the same host, regardless of case, IDNA encoding and trailing dot.
+func (h Hostname) Equal(other Hostname) bool {
+	return equalCanonical(h, other)
+}
+
+// Equal checks if two [IPv4] instances are the same address.
+func (u IPv4) Equal(other IPv4) bool {
+	return equalCanonical(u, other)
+}
+
+// Compare compares two [IPv4] addresses numerically. Invalid addresses sort after valid ones.
+func (u IPv4) Compare(other IPv4) int {
+	a, errA := u.Addr()
+	b, errB := other.Addr()
+
+	return compareAddrs(a, errA, b, errB, string(u), string(other))
+}
+
+// Equal checks if two [IPv6] instances are the same address, regardless of their notation, e.g. "2001:db8::1"
+// and "2001:0DB8:0:0:0:0:0:1".
+func (u IPv6) Equal(other IPv6) bool {
+	return equalCanonical(u, other)
+}
+
+// Compare compares two [IPv6] addresses numerically. Invalid addresses sort after valid ones.
+func (u IPv6) Compare(other IPv6) int {
+	a, errA := u.Addr()
+	b, errB := other.Addr()
+
+	return compareAddrs(a, errA, b, errB, string(u), string(other))
+}
+
+// Equal checks if two [CIDR] instances are the same network, e.g. "192.0.2.1/24" and "192.0.2.0/24".
+func (u CIDR) Equal(other CIDR) bool {
+	if u == other {
+		return true
+	}
+	a, errA := u.Prefix()
+	b, errB := other.Prefix()
+
+	return errA == nil && errB == nil && a.Masked() == b.Masked()
+}
+
+// Equal checks if two [MAC] instances are the same address, regardless of case and separators.
+func (u MAC) Equal(other MAC) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [UUID] instances are the same UUID, regardless of case and dashes.
+func (u UUID) Equal(other UUID) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [UUID3] instances are the same UUID, regardless of case and dashes.
+func (u UUID3) Equal(other UUID3) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [UUID4] instances are the same UUID, regardless of case and dashes.
+func (u UUID4) Equal(other UUID4) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [UUID5] instances are the same UUID, regardless of case and dashes.
+func (u UUID5) Equal(other UUID5) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [UUID7] instances are the same UUID, regardless of case and dashes.
+func (u UUID7) Equal(other UUID7) bool {
+	return equalCanonical(u, other)
+}
+
+// Compare compares two [UUID7] instances, i.e. by their timestamp first. Invalid values sort after valid ones.
+func (u UUID7) Compare(other UUID7) int {
+	return compareCanonical(u, other)
+}
+
+// Equal checks if two [ISBN] instances are the same number, regardless of hyphens and spaces.
+func (u ISBN) Equal(other ISBN) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [ISBN10] instances are the same number, regardless of hyphens and spaces.
+func (u ISBN10) Equal(other ISBN10) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [ISBN13] instances are the same number, regardless of hyphens and spaces.
+func (u ISBN13) Equal(other ISBN13) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [CreditCard] instances are the same number, regardless of hyphens and spaces.
+func (u CreditCard) Equal(other CreditCard) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [SSN] instances are the same number, regardless of hyphens and spaces.
+func (u SSN) Equal(other SSN) bool {
+	return equalCanonical(u, other)
+}
+
+// Equal checks if two [HexColor] instances are the same color, e.g. "#ABC" and "#aabbcc".
+func (h HexColor) Equal(other HexColor) bool {
+	return equalCanonical(h, other)
+}
+
+// Equal checks if two [RGBColor] instances are the same color, regardless of spaces.
+func (r RGBColor) Equal(other RGBColor) bool {
+	return equalCanonical(r, other)
+}
+
+// Equal checks if two [Password] instances are the same, in constant time.
+func (p Password) Equal(other Password) bool {
+	return subtle.ConstantTimeCompare([]byte(p), []byte(other)) == 1
+}
+
+// Equal checks if two [Cron] instances fire at the same times, e.g. "@daily" and "0 0 * * *".
+func (c Cron) Equal(other Cron) bool {
+	if c == other {
+		return true
+	}
+	a, errA := c.Schedule()
+	b, errB := other.Schedule()
+	if errA != nil || errB != nil {
+		return false
+	}
+	a.withSeconds, b.withSeconds = false, false // "0 0 0 * * *" fires like "0 0 * * *"
+
+	return a == b
+}
+
+// Equal checks if two [TimeZone] instances are the same name, or the same fixed offset, e.g. "GMT+02" and "UTC+02:00".
+func (z TimeZone) Equal(other TimeZone) bool {
+	return equalCanonical(z, other)
+}
+
+// Equal checks if two [Duration] instances are equal.
+func (d Duration) Equal(other Duration) bool {
+	return d == other
+}
+
+// Compare compares two [Duration] instances.
+func (d Duration) Compare(other Duration) int {
+	return cmp.Compare(d, other)
+}
+
+// Equal checks if two [Base64] instances hold the same bytes.
+func (b Base64) Equal(other Base64) bool {
+	return bytes.Equal(b, other)
+}
+
+// Equal checks if two [ObjectId] instances are equal.
+func (id ObjectId) Equal(other ObjectId) bool { //nolint:revive
+	return id == other
+}
+
+// Compare compares two [ObjectId] instances byte-wise, i.e. by their timestamp first.
+func (id ObjectId) Compare(other ObjectId) int { //nolint:revive
+	return bytes.Compare(id[:], other[:])
+}
+
+// Compare compares two [ULID] instances, i.e. by their timestamp first.
+func (u ULID) Compare(other ULID) int {
+	return u.ULID.Compare(other.ULID)
+}
+
+// Compare compares two [DateTime] instances as instants.
+func (t DateTime) Compare(other DateTime) int {
+	return time.Time(t).Compare(time.Time(other))
+}
+
+// Compare compares two [Date] instances.
+func (d Date) Compare(other Date) int {
+	return time.Time(d).Compare(time.Time(other))
+}
+
+func equalCanonical[T interface {
+	~string
+	Canonical() (T, error)
+}](a, b T,
+) bool {
+	if a == b {
+		return true
+	}
+	ca, errA := a.Canonical()
+	cb, errB := b.Canonical()
+
+	return errA == nil && errB == nil && ca == cb
+}
+
+// compareCanonical compares the canonical forms of two values, which must sort like the values.
+func compareCanonical[T interface {
+	~string
+	Canonical() (T, error)
+}](a, b T,
+) int {
+	ca, errA := a.Canonical()
+	cb, errB := b.Canonical()
+
+	return compareValid(errA == nil, errB == nil, func() int {
+		return strings.Compare(string(ca), string(cb))
+	}, string(a), string(b))
+}
+
+func compareAddrs(a netip.Addr, errA error, b netip.Addr, errB error, rawA, rawB string) int {
+	return compareValid(errA == nil, errB == nil, func() int { return a.Compare(b) }, rawA, rawB)
+}
+
+// compareValid sorts invalid values after valid ones, and invalid values by their raw string.
+func compareValid(validA, validB bool, compare func() int, rawA, rawB string) int {
+	switch {
+	case validA && validB:
+		return compare()
+	case validA:
+		return -1
+	case validB:
+		return 1
+	default:
+		return strings.Compare(rawA, rawB)
+	}
+}
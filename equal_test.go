@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestEqual(t *testing.T) {
+	t.Run("should compare string formats semantically", func(t *testing.T) {
+		assert.TrueT(t, URI("HTTP://Example.COM/a").Equal("http://example.com/a"))
+		assert.FalseT(t, URI("http://example.com/A").Equal("http://example.com/a"))
+		assert.TrueT(t, Email("John <john@Example.COM>").Equal("john@example.com"))
+		assert.FalseT(t, Email("John@example.com").Equal("john@example.com"))
+		assert.TrueT(t, Hostname("Bücher.example.").Equal("xn--bcher-kva.example"))
+		assert.TrueT(t, IPv4("::ffff:192.0.2.1").Equal("192.0.2.1"))
+		assert.TrueT(t, IPv6("2001:0DB8:0:0:0:0:0:1").Equal("2001:db8::1"))
+		assert.FalseT(t, IPv6("2001:db8::1").Equal("2001:db8::2"))
+		assert.TrueT(t, CIDR("192.0.2.1/24").Equal("192.0.2.0/24"))
+		assert.FalseT(t, CIDR("192.0.2.0/24").Equal("192.0.2.0/25"))
+		assert.TrueT(t, MAC("0123.4567.89AB").Equal("01:23:45:67:89:ab"))
+		assert.TrueT(t, UUID("F47AC10B58CC4372A5670E02B2C3D479").Equal("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+		assert.TrueT(t, UUID4("F47AC10B58CC4372A5670E02B2C3D479").Equal("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+		assert.TrueT(t, UUID7("01890A5D-AC96-774B-BCCE-B302099A8057").Equal("01890a5d-ac96-774b-bcce-b302099a8057"))
+		assert.TrueT(t, ISBN("978-0-306-40615-7").Equal("9780306406157"))
+		assert.TrueT(t, ISBN10("0 306 40615 2").Equal("0306406152"))
+		assert.TrueT(t, ISBN13("978 0306406157").Equal("9780306406157"))
+		assert.TrueT(t, CreditCard("4111 1111 1111 1111").Equal("4111-1111-1111-1111"))
+		assert.TrueT(t, SSN("123 45 6789").Equal("123-45-6789"))
+		assert.TrueT(t, HexColor("#ABC").Equal("aabbcc"))
+		assert.TrueT(t, RGBColor("rgb(1,2,3)").Equal("rgb( 1, 2, 3 )"))
+		assert.TrueT(t, Cron("@daily").Equal("0 0 * * *"))
+		assert.TrueT(t, Cron("0 0 0 * * *").Equal("0 0 * * *"))
+		assert.FalseT(t, Cron("0 0 * * *").Equal("0 1 * * *"))
+		assert.TrueT(t, Cron("0 0 * * 0").Equal("0 0 * * 7"))
+		assert.TrueT(t, Cron("0 0 * * SUN,MON").Equal("0 0 * * 1,7"))
+		assert.FalseT(t, Cron("0 0 * * 0").Equal("0 0 * * 6"))
+		assert.TrueT(t, Password("secret").Equal("secret"))
+		assert.FalseT(t, Password("secret").Equal("Secret"))
+	})
+
+	t.Run("should compare invalid values as strings", func(t *testing.T) {
+		assert.TrueT(t, UUID("not-a-uuid").Equal("not-a-uuid"))
+		assert.FalseT(t, UUID("not-a-uuid").Equal("NOT-A-UUID"))
+		assert.FalseT(t, UUID4("f47ac10b-58cc-4372-a567-0e02b2c3d479").Equal("F47AC10B-58CC-3372-A567-0E02B2C3D479"))
+		assert.FalseT(t, CIDR("192.0.2.0").Equal("192.0.2.0/32"))
+		assert.FalseT(t, Cron("* *").Equal("* * * * *"))
+	})
+
+	t.Run("should compare other formats", func(t *testing.T) {
+		assert.TrueT(t, Duration(time.Hour).Equal(Duration(60*time.Minute)))
+		assert.TrueT(t, Base64("hello").Equal(Base64("hello")))
+		assert.FalseT(t, Base64("hello").Equal(nil))
+		assert.TrueT(t, NewObjectId("507f1f77bcf86cd799439011").Equal(NewObjectId("507f1f77bcf86cd799439011")))
+	})
+}
+
+func TestCompare(t *testing.T) {
+	t.Run("should sort IP addresses numerically", func(t *testing.T) {
+		ips := []IPv4{"invalid", "192.0.2.10", "10.0.0.1", "192.0.2.9", "::ffff:10.0.0.0"}
+		slices.SortFunc(ips, IPv4.Compare)
+		assert.Equal(t, []IPv4{"::ffff:10.0.0.0", "10.0.0.1", "192.0.2.9", "192.0.2.10", "invalid"}, ips)
+
+		ips6 := []IPv6{"2001:db8::a", "2001:DB8::9", "::1"}
+		slices.SortFunc(ips6, IPv6.Compare)
+		assert.Equal(t, []IPv6{"::1", "2001:DB8::9", "2001:db8::a"}, ips6)
+		assert.EqualT(t, 0, IPv6("2001:db8::1").Compare("2001:0DB8:0:0:0:0:0:1"))
+	})
+
+	t.Run("should sort UUID7 by time", func(t *testing.T) {
+		ids := []UUID7{"01890A5D-AC97-774B-BCCE-B302099A8057", "bogus", "01890a5d-ac96-774b-bcce-b302099a8057"}
+		slices.SortFunc(ids, UUID7.Compare)
+		assert.Equal(t, []UUID7{"01890a5d-ac96-774b-bcce-b302099a8057", "01890A5D-AC97-774B-BCCE-B302099A8057", "bogus"}, ids)
+	})
+
+	t.Run("should sort time formats", func(t *testing.T) {
+		base := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		paris := time.FixedZone("CEST", 2*60*60)
+
+		dts := []DateTime{DateTime(base.Add(time.Hour)), DateTime(base.In(paris)), DateTime(base.Add(-time.Hour))}
+		slices.SortFunc(dts, DateTime.Compare)
+		assert.TrueT(t, time.Time(dts[0]).Equal(base.Add(-time.Hour)))
+		assert.TrueT(t, time.Time(dts[2]).Equal(base.Add(time.Hour)))
+		assert.EqualT(t, 0, DateTime(base).Compare(DateTime(base.In(paris))))
+
+		dates := []Date{Date(base.AddDate(0, 0, 1)), Date(base)}
+		slices.SortFunc(dates, Date.Compare)
+		assert.EqualT(t, Date(base), dates[0])
+
+		durations := []Duration{Duration(time.Hour), Duration(-time.Second), 0}
+		slices.SortFunc(durations, Duration.Compare)
+		assert.Equal(t, []Duration{Duration(-time.Second), 0, Duration(time.Hour)}, durations)
+	})
+
+	t.Run("should sort identifiers", func(t *testing.T) {
+		ids := []ObjectId{NewObjectId("507f1f77bcf86cd799439012"), NewObjectId("507f1f77bcf86cd799439011")}
+		slices.SortFunc(ids, ObjectId.Compare)
+		assert.EqualT(t, NewObjectId("507f1f77bcf86cd799439011"), ids[0])
+
+		first, err := ParseULID("01EYXZVGBHG26MFTG4JWR4K558")
+		require.NoError(t, err)
+		second, err := ParseULID("01EYXZW663G7PYHVSQ8B7A4Q8Q")
+		require.NoError(t, err)
+		ulids := []ULID{second, first}
+		slices.SortFunc(ulids, ULID.Compare)
+		assert.EqualT(t, first, ulids[0])
+		assert.EqualT(t, 0, first.Compare(first))
+	})
+}
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// rfc3339UnknownOffset is the offset notation of a UTC time with an unknown local offset (RFC 3339, section 4.3).
+	rfc3339UnknownOffset = "-00:00"
+
+	exactDateLayout  = "2006-01-02"
+	exactClockLayout = "15:04:05"
+
+	// maxFractionalDigits is the maximum number of fractional second digits supported by [time.Time].
+	maxFractionalDigits = 9
+)
+
+// ExactDateTime is a RFC 3339 date-time that marshals back exactly as it was parsed.
+//
+// Unlike [DateTime], which always renders with [MarshalFormat] after [NormalizeTimeForMarshal],
+// an [ExactDateTime] remembers the offset notation, the date/time separator, the decimal sign
+// and the number of fractional second digits of its input, e.g. "2024-05-01T10:00:00.123456+02:00"
+// is rendered unchanged. This is useful for signed or audited payloads.
+//
+// [ExactDateTime] is an alternative Go type for the "date-time" format: the global time settings do not apply to it.
+type ExactDateTime struct {
+	time   time.Time
+	layout string
+}
+
+// NewExactDateTime builds an [ExactDateTime] from a time, keeping its offset and rendering it
+// with the shortest RFC 3339 representation that preserves its precision.
+func NewExactDateTime(t time.Time) ExactDateTime {
+	return ExactDateTime{time: t, layout: time.RFC3339Nano}
+}
+
+// ParseExactDateTime parses a RFC 3339 date-time, remembering its representation.
+//
+// The date and time may be separated by 'T', 't' or a space. The fractional seconds may use '.' or ','.
+// The offset may be 'Z', 'z', "-00:00" or a numerical offset.
+// Other fields have the fixed width of RFC 3339: input which would not render back byte for byte is rejected.
+func ParseExactDateTime(data string) (ExactDateTime, error) {
+	layout, err := exactLayout(data)
+	if err != nil {
+		return ExactDateTime{}, err
+	}
+
+	t, err := time.Parse(layout, data)
+	if err != nil {
+		return ExactDateTime{}, fmt.Errorf("invalid date-time %q: %w: %w", data, err, ErrFormat)
+	}
+	if t.Format(layout) != data {
+		// time.Parse is lenient, e.g. with unpadded fields or an offset of 60 minutes
+		return ExactDateTime{}, fmt.Errorf("invalid date-time %q: not rendered back as such: %w", data, ErrFormat)
+	}
+
+	return ExactDateTime{time: t, layout: layout}, nil
+}
+
+// exactLayout infers the time layout which reproduces a RFC 3339 date-time.
+func exactLayout(data string) (string, error) {
+	const (
+		dateTimeLen = len(exactDateLayout) + 1 + len(exactClockLayout)
+		sepPos      = len(exactDateLayout)
+	)
+
+	if len(data) <= dateTimeLen {
+		return "", fmt.Errorf("invalid date-time %q: too short: %w", data, ErrFormat)
+	}
+
+	var layout strings.Builder
+	layout.Grow(len(data))
+	layout.WriteString(exactDateLayout)
+
+	switch sep := data[sepPos]; sep {
+	case 'T', 't', ' ':
+		layout.WriteByte(sep)
+	default:
+		return "", fmt.Errorf("invalid date-time %q: unexpected separator %q: %w", data, sep, ErrFormat)
+	}
+	layout.WriteString(exactClockLayout)
+
+	rest := data[dateTimeLen:]
+	if rest[0] == '.' || rest[0] == ',' {
+		layout.WriteByte(rest[0])
+		digits := 0
+		for digits+1 < len(rest) && isASCIIDigit(rest[digits+1]) {
+			digits++
+		}
+		if digits == 0 || digits > maxFractionalDigits {
+			return "", fmt.Errorf("invalid date-time %q: invalid fractional seconds: %w", data, ErrFormat)
+		}
+		layout.WriteString(strings.Repeat("0", digits))
+		rest = rest[digits+1:]
+	}
+
+	switch rest {
+	case "Z", "z", rfc3339UnknownOffset:
+		// kept as a literal: the time is parsed as UTC
+		layout.WriteString(rest)
+	case "":
+		return "", fmt.Errorf("invalid date-time %q: missing offset: %w", data, ErrFormat)
+	default:
+		if !isNumOffset(rest) {
+			return "", fmt.Errorf("invalid date-time %q: invalid offset: %w", data, ErrFormat)
+		}
+		layout.WriteString("-07:00")
+	}
+
+	return layout.String(), nil
+}
+
+// isNumOffset tells if the string is a RFC 3339 numerical offset, e.g. "+02:00".
+func isNumOffset(s string) bool {
+	if len(s) != len("+07:00") || (s[0] != '+' && s[0] != '-') || s[3] != ':' {
+		return false
+	}
+	hour, okHour := atoiDigits(s[1:3])
+	minute, okMinute := atoiDigits(s[4:6])
+
+	return okHour && okMinute && hour <= lastHourOfDay && minute <= lastMinuteOfHour
+}
+
+// Time returns the time.
+func (t ExactDateTime) Time() time.Time {
+	return t.time
+}
+
+// DateTime returns the time as a [DateTime].
+func (t ExactDateTime) DateTime() DateTime {
+	return DateTime(t.time)
+}
+
+// Layout returns the time layout used to render this date-time.
+func (t ExactDateTime) Layout() string {
+	if t.layout == "" {
+		return time.RFC3339Nano
+	}
+
+	return t.layout
+}
+
+// String converts this date-time to a string, with the same representation it was parsed from.
+func (t ExactDateTime) String() string {
+	return t.time.Format(t.Layout())
+}
+
+// IsZero returns whether the date-time is a zero value.
+func (t ExactDateTime) IsZero() bool {
+	return t.time.IsZero()
+}
+
+// Equal checks if two [ExactDateTime] instances represent the same instant, regardless of their representation.
+func (t ExactDateTime) Equal(other ExactDateTime) bool {
+	return t.time.Equal(other.time)
+}
+
+// MarshalText implements the text marshaler interface.
+func (t ExactDateTime) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaler interface.
+func (t *ExactDateTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = ExactDateTime{}
+		return nil
+	}
+	v, err := ParseExactDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// Scan scans an [ExactDateTime] value from database driver type.
+func (t *ExactDateTime) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return t.UnmarshalText(v)
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case time.Time:
+		*t = NewExactDateTime(v)
+	case nil:
+		*t = ExactDateTime{}
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.ExactDateTime from: %#v: %w", v, ErrFormat)
+	}
+
+	return nil
+}
+
+// Value converts [ExactDateTime] to a primitive value ready to written to a database.
+//
+// The value is a string, so the representation is preserved.
+func (t ExactDateTime) Value() (driver.Value, error) {
+	return driver.Value(t.String()), nil
+}
+
+// MarshalJSON returns the [ExactDateTime] as JSON.
+func (t ExactDateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON sets the [ExactDateTime] from JSON.
+func (t *ExactDateTime) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+
+	var tstr string
+	if err := json.Unmarshal(data, &tstr); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(tstr))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (t *ExactDateTime) DeepCopyInto(out *ExactDateTime) {
+	*out = *t
+}
+
+// DeepCopy copies the receiver into a new [ExactDateTime].
+func (t *ExactDateTime) DeepCopy() *ExactDateTime {
+	if t == nil {
+		return nil
+	}
+	out := new(ExactDateTime)
+	t.DeepCopyInto(out)
+	return out
+}
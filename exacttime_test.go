@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestExactDateTime_RoundTrip(t *testing.T) {
+	oldFormat, oldNormalize := MarshalFormat, NormalizeTimeForMarshal
+	t.Cleanup(func() {
+		MarshalFormat, NormalizeTimeForMarshal = oldFormat, oldNormalize
+	})
+	// global settings do not apply
+	MarshalFormat = RFC3339Millis
+	NormalizeTimeForMarshal = func(t time.Time) time.Time { return t.UTC() }
+
+	for _, input := range []string{
+		"2024-05-01T10:00:00.123456+02:00",
+		"2024-05-01T10:00:00+02:00",
+		"2024-05-01T10:00:00.100Z",
+		"2024-05-01T10:00:00.000000000Z",
+		"2024-05-01t10:00:00z",
+		"2024-05-01 10:00:00,5-05:30",
+		"2024-05-01T10:00:00+00:00",
+		"2024-05-01T10:00:00-00:00",
+	} {
+		t.Run(input, func(t *testing.T) {
+			dt, err := ParseExactDateTime(input)
+			require.NoError(t, err)
+			assert.EqualT(t, input, dt.String())
+
+			b, err := json.Marshal(dt)
+			require.NoError(t, err)
+			assert.EqualT(t, `"`+input+`"`, string(b))
+
+			var back ExactDateTime
+			require.NoError(t, json.Unmarshal(b, &back))
+			assert.EqualT(t, input, back.String())
+			assert.TrueT(t, dt.Equal(back))
+
+			v, err := dt.Value()
+			require.NoError(t, err)
+			assert.Equal(t, input, v)
+
+			bsonData, err := dt.MarshalBSON()
+			require.NoError(t, err)
+			var fromBSON ExactDateTime
+			require.NoError(t, fromBSON.UnmarshalBSON(bsonData))
+			assert.EqualT(t, input, fromBSON.String())
+		})
+	}
+}
+
+func TestExactDateTime_Time(t *testing.T) {
+	dt, err := ParseExactDateTime("2024-05-01T10:00:00.123456+02:00")
+	require.NoError(t, err)
+
+	_, offset := dt.Time().Zone()
+	assert.EqualT(t, 2*3600, offset)
+	assert.EqualT(t, 123456000, dt.Time().Nanosecond())
+	assert.TrueT(t, dt.DateTime().Equal(DateTime(time.Date(2024, 5, 1, 8, 0, 0, 123456000, time.UTC))))
+
+	unknown, err := ParseExactDateTime("2024-05-01T10:00:00-00:00")
+	require.NoError(t, err)
+	assert.EqualT(t, time.UTC, unknown.Time().Location())
+
+	built := NewExactDateTime(time.Date(2024, 5, 1, 10, 0, 0, 500, time.FixedZone("", -3600)))
+	assert.EqualT(t, "2024-05-01T10:00:00.0000005-01:00", built.String())
+	assert.EqualT(t, "0001-01-01T00:00:00Z", ExactDateTime{}.String())
+	assert.TrueT(t, ExactDateTime{}.IsZero())
+}
+
+func TestExactDateTime_Invalid(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"2024-05-01",
+		"2024-05-01T10:00:00",
+		"2024-05-01X10:00:00Z",
+		"2024-05-01T10:00:00.Z",
+		"2024-05-01T10:00:00.1234567890Z",
+		"2024-05-01T10:00:00+0200",
+		"2024-13-01T10:00:00Z",
+		"2024-05-01T10:00:00Zjunk",
+		"2024-05-01T1:20:30.123456+02:00",
+		"2024-05-01  10:20:30-00:00",
+		"2024-05-01T10:20:30+02:60",
+		"2024-05-01T10:20:30+24:00",
+		"2024-05-01T10:20:60Z",
+	} {
+		_, err := ParseExactDateTime(input)
+		require.ErrorIsf(t, err, ErrFormat, "expected %q to fail", input)
+	}
+
+	var dt ExactDateTime
+	require.NoError(t, dt.UnmarshalJSON([]byte(jsonNull)))
+	require.Error(t, dt.UnmarshalJSON([]byte(`1`)))
+}
+
+func TestExactDateTime_Scan(t *testing.T) {
+	const input = "2024-05-01T10:00:00.120+02:00"
+
+	var dt ExactDateTime
+	require.NoError(t, dt.Scan(input))
+	assert.EqualT(t, input, dt.String())
+
+	require.NoError(t, dt.Scan([]byte(input)))
+	assert.EqualT(t, input, dt.String())
+
+	now := time.Now()
+	require.NoError(t, dt.Scan(now))
+	assert.TrueT(t, now.Equal(dt.Time()))
+
+	require.NoError(t, dt.Scan(nil))
+	assert.TrueT(t, dt.IsZero())
+
+	require.ErrorIs(t, dt.Scan(int64(1)), ErrFormat)
+}
+
+func TestDeepCopyExactDateTime(t *testing.T) {
+	dt, err := ParseExactDateTime("2024-05-01T10:00:00.120+02:00")
+	require.NoError(t, err)
+
+	out := dt.DeepCopy()
+	assert.EqualT(t, dt, *out)
+
+	var nilDT *ExactDateTime
+	assert.Nil(t, nilDT.DeepCopy())
+}
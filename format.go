@@ -10,7 +10,6 @@ import (
 	"slices"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-viper/mapstructure/v2"
@@ -22,10 +21,83 @@ var Default = NewSeededFormats(nil, nil) //nolint:gochecknoglobals // package-le
 // Validator represents a validator for a string format.
 type Validator func(string) bool
 
+// RegistryOption configures a registry created by [NewFormats] or [NewStrictFormats].
+type RegistryOption func(*defaultFormats)
+
+// WithTimeConfig sets the [TimeConfig] used by a registry to validate and parse "date" and "date-time" values,
+// instead of the package-level settings.
+//
+// Such a registry validates these values by parsing them, so that its layouts are accepted by [Registry.Validates].
+// A strict registry (see [NewStrictFormats]) keeps validating "date-time" values along RFC 3339.
+func WithTimeConfig(c TimeConfig) RegistryOption {
+	return func(f *defaultFormats) {
+		f.timeConfig = &c
+	}
+}
+
+// TimeConfigOf returns the [TimeConfig] of a registry, resolved against the package-level settings.
+//
+// Registries which are not created by this package follow the package-level settings.
+func TimeConfigOf(r Registry) TimeConfig {
+	if f, ok := r.(*defaultFormats); ok {
+		return f.times().resolved()
+	}
+
+	return DefaultTimeConfig()
+}
+
+// WithDurationUnits sets the [DurationUnits] used by a registry to parse and validate "duration" values,
+// instead of the units known to [ParseDuration].
+func WithDurationUnits(u *DurationUnits) RegistryOption {
+	return func(f *defaultFormats) {
+		f.durationUnits = u
+	}
+}
+
+// DurationUnitsOf returns the [DurationUnits] of a registry, or nil when the registry uses the units known to [ParseDuration].
+func DurationUnitsOf(r Registry) *DurationUnits {
+	if f, ok := r.(*defaultFormats); ok {
+		return f.durationUnits
+	}
+
+	return nil
+}
+
+// WithValidation makes a registry validate values against its validators when parsing them with [Registry.Parse]
+// or decoding them with [Registry.MapStructureHookFunc], e.g. for formats based on a string such as "email".
+//
+// Invalid values yield a [*ValidationError]. See also [ValidateOnUnmarshal].
+func WithValidation() RegistryOption {
+	return func(f *defaultFormats) {
+		f.validateValues = true
+	}
+}
+
 // NewFormats creates a new formats registry seeded with the values from the default.
-func NewFormats() Registry { //nolint:ireturn // factory function returns the Registry interface by design
+func NewFormats(opts ...RegistryOption) Registry { //nolint:ireturn // factory function returns the Registry interface by design
 	//nolint:forcetypeassert
-	return NewSeededFormats(Default.(*defaultFormats).data, nil)
+	f := NewSeededFormats(Default.(*defaultFormats).data, nil).(*defaultFormats)
+	for _, apply := range opts {
+		apply(f)
+	}
+
+	return f
+}
+
+// NewStrictFormats creates a new formats registry seeded with the values from the default,
+// which validates and parses the "date-time" format strictly along the grammar of RFC 3339.
+//
+// This is the behavior expected by JSON Schema. See [IsRFC3339DateTime] and [ParseRFC3339DateTime].
+//
+// Registries created with [NewFormats] keep the lenient behavior of [IsDateTime] and [ParseDateTime].
+func NewStrictFormats(opts ...RegistryOption) Registry { //nolint:ireturn // factory function returns the Registry interface by design
+	//nolint:forcetypeassert
+	f := NewFormats(opts...).(*defaultFormats)
+	f.strict = true
+	dt := DateTime{}
+	f.Add("date-time", &dt, IsRFC3339DateTime)
+
+	return f
 }
 
 // NewSeededFormats creates a new formats registry.
@@ -58,8 +130,12 @@ func DefaultNameNormalizer(name string) string {
 type defaultFormats struct {
 	sync.Mutex
 
-	data          []knownFormat
-	normalizeName NameNormalizer
+	data           []knownFormat
+	normalizeName  NameNormalizer
+	strict         bool
+	timeConfig     *TimeConfig
+	durationUnits  *DurationUnits
+	validateValues bool
 }
 
 // MapStructureHookFunc is a decode hook function for mapstructure.
@@ -76,7 +152,10 @@ func (f *defaultFormats) MapStructureHookFunc() mapstructure.DecodeHookFunc { //
 		for _, v := range f.data {
 			tpe, _ := f.GetType(v.Name)
 			if to == tpe {
-				return decodeFormatFromString(v.Name, data)
+				if err := f.checkValue(v, v.Name, data); err != nil {
+					return nil, err
+				}
+				return f.decodeFormatFromString(v.Name, tpe, data)
 			}
 		}
 		return data, nil
@@ -84,21 +163,22 @@ func (f *defaultFormats) MapStructureHookFunc() mapstructure.DecodeHookFunc { //
 }
 
 // decodeFormatFromString decodes a string into the appropriate format type by name.
-func decodeFormatFromString(name, data string) (any, error) { //nolint:gocyclo,cyclop // flat switch over format names, no real complexity
+//
+// Formats which are not built-in are decoded by the [encoding.TextUnmarshaler] of their type.
+func (f *defaultFormats) decodeFormatFromString(name string, tpe reflect.Type, data string) (any, error) { //nolint:gocyclo,cyclop // flat switch over format names, no real complexity
 	switch name {
 	case "date":
-		d, err := time.ParseInLocation(RFC3339FullDate, data, DefaultTimeLocation)
-		if err != nil {
-			return nil, err
-		}
-		return Date(d), nil
+		return f.times().ParseDate(data)
 	case "datetime":
 		if len(data) == 0 {
 			return nil, fmt.Errorf("empty string is an invalid datetime format: %w", ErrFormat)
 		}
-		return ParseDateTime(data)
+		if f.strict {
+			return ParseRFC3339DateTime(data)
+		}
+		return f.times().ParseDateTime(data)
 	case "duration":
-		dur, err := ParseDuration(data)
+		dur, err := f.durationUnits.ParseDuration(data)
 		if err != nil {
 			return nil, err
 		}
@@ -151,8 +231,34 @@ func decodeFormatFromString(name, data string) (any, error) { //nolint:gocyclo,c
 			return nil, err
 		}
 		return ulid, nil
+	case "interval":
+		return ParseInterval(data)
+	case "repeatinginterval":
+		return ParseRepeatingInterval(data)
+	case "yearmonth":
+		return ParseYearMonth(data)
+	case "year":
+		return ParseYear(data)
+	case "zoneddatetime":
+		return ParseZonedDateTime(data)
+	case "timezone":
+		return TimeZone(data), nil
+	case "httpdate":
+		return ParseHTTPDate(data)
+	case "cron":
+		return Cron(data), nil
+	case "rrule":
+		return ParseRRule(data)
 	default:
-		return nil, errors.InvalidTypeName(name)
+		nw := reflect.New(tpe)
+		dec, ok := nw.Interface().(encoding.TextUnmarshaler)
+		if !ok {
+			return nil, errors.InvalidTypeName(name)
+		}
+		if err := dec.UnmarshalText([]byte(data)); err != nil {
+			return nil, err
+		}
+		return nw.Elem().Interface(), nil
 	}
 }
 
@@ -272,12 +378,36 @@ func (f *defaultFormats) Validates(name, data string) bool {
 	nme := f.normalizeName(name)
 	for _, v := range f.data {
 		if v.Name == nme {
-			return v.Validator(data)
+			return f.validates(v, data)
 		}
 	}
 	return false
 }
 
+func (f *defaultFormats) validates(v knownFormat, data string) bool {
+	switch {
+	case v.Name == "duration" && f.durationUnits != nil && v.Type == reflect.TypeFor[Duration]():
+		return f.durationUnits.IsDuration(data)
+	case v.Name == "datetime" && f.timeConfig != nil && !f.strict && v.Type == reflect.TypeFor[DateTime]():
+		_, err := f.timeConfig.ParseDateTime(data)
+		return data != "" && err == nil
+	case v.Name == "date" && f.timeConfig != nil && v.Type == reflect.TypeFor[Date]():
+		_, err := f.timeConfig.ParseDate(data)
+		return data != "" && err == nil
+	}
+
+	return v.Validator(data)
+}
+
+// times returns the [TimeConfig] of the registry, or the zero [TimeConfig] which follows the package-level settings.
+func (f *defaultFormats) times() TimeConfig {
+	if f.timeConfig == nil {
+		return TimeConfig{}
+	}
+
+	return *f.timeConfig
+}
+
 // Parse a string into the appropriate format representation type.
 //
 // E.g. parsing a string a "date" will return a Date type.
@@ -287,6 +417,19 @@ func (f *defaultFormats) Parse(name, data string) (any, error) {
 	nme := f.normalizeName(name)
 	for _, v := range f.data {
 		if v.Name == nme {
+			if err := f.checkValue(v, name, data); err != nil {
+				return nil, err
+			}
+			switch {
+			case nme == "datetime" && f.strict:
+				return parseStrictDateTime(data)
+			case nme == "datetime" && v.Type == reflect.TypeFor[DateTime]():
+				return parseDateTimeWith(f.times(), data)
+			case nme == "date" && v.Type == reflect.TypeFor[Date]():
+				return parseDateWith(f.times(), data)
+			case nme == "duration" && v.Type == reflect.TypeFor[Duration]():
+				return parseDurationWith(f.durationUnits, data)
+			}
 			nw := reflect.New(v.Type).Interface()
 			if dec, ok := nw.(encoding.TextUnmarshaler); ok {
 				if err := dec.UnmarshalText([]byte(data)); err != nil {
@@ -299,3 +442,44 @@ func (f *defaultFormats) Parse(name, data string) (any, error) {
 	}
 	return nil, errors.InvalidTypeName(name)
 }
+
+// parseDateTimeWith parses a date-time with the settings of a registry, returning a pointer like [Registry.Parse] does.
+func parseDateTimeWith(c TimeConfig, data string) (any, error) {
+	dt, err := c.ParseDateTime(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dt, nil
+}
+
+// parseDateWith parses a date with the settings of a registry, returning a pointer like [Registry.Parse] does.
+func parseDateWith(c TimeConfig, data string) (any, error) {
+	d, err := c.ParseDate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// parseDurationWith parses a duration with the units of a registry, returning a pointer like [Registry.Parse] does.
+func parseDurationWith(u *DurationUnits, data string) (any, error) {
+	d, err := u.ParseDuration(data)
+	if err != nil {
+		return nil, err
+	}
+	dur := Duration(d)
+
+	return &dur, nil
+}
+
+// parseStrictDateTime parses a date-time for a strict registry, returning a pointer like [Registry.Parse] does.
+func parseStrictDateTime(data string) (any, error) {
+	dt, err := ParseRFC3339DateTime(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dt, nil
+}
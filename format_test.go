@@ -118,31 +118,38 @@ func TestFormatRegistry(t *testing.T) {
 }
 
 type testStruct struct {
-	D          Date       `json:"d"`
-	DT         DateTime   `json:"dt"`
-	Dur        Duration   `json:"dur,omitempty"`
-	URI        URI        `json:"uri,omitempty"`
-	Eml        Email      `json:"eml,omitempty"`
-	UUID       UUID       `json:"uuid,omitempty"`
-	UUID3      UUID3      `json:"uuid3,omitempty"`
-	UUID4      UUID4      `json:"uuid4,omitempty"`
-	UUID5      UUID5      `json:"uuid5,omitempty"`
-	UUID7      UUID7      `json:"uuid7,omitempty"`
-	Hn         Hostname   `json:"hn,omitempty"`
-	Ipv4       IPv4       `json:"ipv4,omitempty"`
-	Ipv6       IPv6       `json:"ipv6,omitempty"`
-	Cidr       CIDR       `json:"cidr,omitempty"`
-	Mac        MAC        `json:"mac,omitempty"`
-	Isbn       ISBN       `json:"isbn,omitempty"`
-	Isbn10     ISBN10     `json:"isbn10,omitempty"`
-	Isbn13     ISBN13     `json:"isbn13,omitempty"`
-	Creditcard CreditCard `json:"creditcard,omitempty"`
-	Ssn        SSN        `json:"ssn,omitempty"`
-	Hexcolor   HexColor   `json:"hexcolor,omitempty"`
-	Rgbcolor   RGBColor   `json:"rgbcolor,omitempty"`
-	B64        Base64     `json:"b64,omitempty"`
-	Pw         Password   `json:"pw,omitempty"`
-	ULID       ULID       `json:"ulid"`
+	D          Date              `json:"d"`
+	DT         DateTime          `json:"dt"`
+	Dur        Duration          `json:"dur,omitempty"`
+	URI        URI               `json:"uri,omitempty"`
+	Eml        Email             `json:"eml,omitempty"`
+	UUID       UUID              `json:"uuid,omitempty"`
+	UUID3      UUID3             `json:"uuid3,omitempty"`
+	UUID4      UUID4             `json:"uuid4,omitempty"`
+	UUID5      UUID5             `json:"uuid5,omitempty"`
+	UUID7      UUID7             `json:"uuid7,omitempty"`
+	Hn         Hostname          `json:"hn,omitempty"`
+	Ipv4       IPv4              `json:"ipv4,omitempty"`
+	Ipv6       IPv6              `json:"ipv6,omitempty"`
+	Cidr       CIDR              `json:"cidr,omitempty"`
+	Mac        MAC               `json:"mac,omitempty"`
+	Isbn       ISBN              `json:"isbn,omitempty"`
+	Isbn10     ISBN10            `json:"isbn10,omitempty"`
+	Isbn13     ISBN13            `json:"isbn13,omitempty"`
+	Creditcard CreditCard        `json:"creditcard,omitempty"`
+	Ssn        SSN               `json:"ssn,omitempty"`
+	Hexcolor   HexColor          `json:"hexcolor,omitempty"`
+	Rgbcolor   RGBColor          `json:"rgbcolor,omitempty"`
+	B64        Base64            `json:"b64,omitempty"`
+	Pw         Password          `json:"pw,omitempty"`
+	ULID       ULID              `json:"ulid"`
+	Interval   Interval          `json:"interval"`
+	RInterval  RepeatingInterval `json:"rinterval"`
+	Zoned      ZonedDateTime     `json:"zoned"`
+	TZ         TimeZone          `json:"tz,omitempty"`
+	HTTPDate   HTTPDate          `json:"httpdate"`
+	Cron       Cron              `json:"cron,omitempty"`
+	RRule      RRule             `json:"rrule"`
 }
 
 func TestDecodeHook(t *testing.T) {
@@ -173,12 +180,24 @@ func TestDecodeHook(t *testing.T) {
 		"creditcard": "4111-1111-1111-1111",
 		"b64":        "ZWxpemFiZXRocG9zZXk=",
 		"ulid":       "7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		"interval":   "2024-01-01/P1M",
+		"rinterval":  "R2/2024-01-01/P1D",
+		"zoned":      "2024-05-01T10:00:00+02:00[Europe/Paris]",
+		"tz":         "Europe/Paris",
+		"httpdate":   "Sun, 06 Nov 1994 08:49:37 GMT",
+		"cron":       "*/15 * * * *",
+		"rrule":      "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10",
 	}
 
 	date, _ := time.Parse(RFC3339FullDate, "2014-12-15")
 	dur, _ := ParseDuration("5s")
 	dt, _ := ParseDateTime("2012-03-02T15:06:05.999999999Z")
 	ulid, _ := ParseULID("7ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+	interval, _ := ParseInterval("2024-01-01/P1M")
+	rinterval, _ := ParseRepeatingInterval("R2/2024-01-01/P1D")
+	zoned, _ := ParseZonedDateTime("2024-05-01T10:00:00+02:00[Europe/Paris]")
+	httpDate, _ := ParseHTTPDate("Sun, 06 Nov 1994 08:49:37 GMT")
+	rrule, _ := ParseRRule("FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10")
 
 	exp := &testStruct{
 		D:          Date(date),
@@ -206,6 +225,13 @@ func TestDecodeHook(t *testing.T) {
 		B64:        Base64("ZWxpemFiZXRocG9zZXk="),
 		Pw:         Password("super secret stuff here"),
 		ULID:       ulid,
+		Interval:   interval,
+		RInterval:  rinterval,
+		Zoned:      zoned,
+		TZ:         TimeZone("Europe/Paris"),
+		HTTPDate:   httpDate,
+		Cron:       Cron("*/15 * * * *"),
+		RRule:      rrule,
 	}
 
 	test := new(testStruct)
@@ -265,10 +291,6 @@ func TestDecode_ULID_Hook_Negative(t *testing.T) {
 		Name  string
 		Input string
 	}{
-		{
-			"empty string for ulid",
-			"",
-		},
 		{
 			"invalid non empty ulid",
 			"8000000000YYYYYYYYYYYYYYYY",
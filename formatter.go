@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// humanUnit is a unit used to render humanized durations.
+//
+// The names are taken from the aliases accepted by [ParseDuration], so that humanized durations parse back.
+type humanUnit struct {
+	unit     uint64
+	singular string
+	plural   string
+}
+
+//nolint:gochecknoglobals // immutable lookup table
+var humanUnits = [...]humanUnit{
+	{weeks, "week", "weeks"},
+	{days, "day", "days"},
+	{hours, "hour", "hours"},
+	{minutes, "minute", "minutes"},
+	{seconds, "second", "seconds"},
+	{millis, "millisecond", "milliseconds"},
+	{micros, "microsecond", "microseconds"},
+	{nanos, "nanosecond", "nanoseconds"},
+}
+
+// Format implements [fmt.Formatter] for [DateTime].
+//
+// The following verbs are supported:
+//
+//   - %v, %+v, %s: the same as [DateTime.String], i.e. [MarshalFormat] after [NormalizeTimeForMarshal].
+//     A precision sets the number of fractional digits of the seconds instead, e.g. "%.0v" or "%.9s".
+//   - %q: the same as %s, double-quoted.
+//   - %d: the number of seconds since the unix epoch. Flags apply like for integers.
+//
+// A width pads the result with spaces, on the left or on the right with the '-' flag.
+//
+// Use [DateTime.Since] to render the time relative to another one, humanized.
+func (t DateTime) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), time.Time(t).Unix())
+	case 'v', 's':
+		writePadded(f, t.formatPrecision(f))
+	case 'q':
+		writePadded(f, strconv.Quote(t.formatPrecision(f)))
+	default:
+		fmt.Fprintf(f, "%%!%c(strfmt.DateTime=%s)", verb, t.String())
+	}
+}
+
+// Since renders the time relative to now, humanized with at most the given number of units,
+// e.g. "3 minutes ago" or "in 1 hour 5 minutes". All the units are used when units <= 0.
+//
+// Differences shorter than a second render as "now".
+func (t DateTime) Since(now time.Time, units int) string {
+	return humanizeSince(time.Time(t), now, units)
+}
+
+// formatPrecision renders the date-time like [DateTime.String], with the number of fractional digits
+// given by the precision of f, when any.
+func (t DateTime) formatPrecision(f fmt.State) string {
+	digits, ok := f.Precision()
+	if !ok {
+		return t.String()
+	}
+
+	layout := "2006-01-02T15:04:05"
+	if digits = min(digits, maxFractionalDigits); digits > 0 {
+		layout += "." + strings.Repeat("0", digits)
+	}
+
+	return TimeConfig{}.resolved().NormalizeTimeForMarshal(time.Time(t)).Format(layout + "Z07:00")
+}
+
+// Format implements [fmt.Formatter] for [Duration].
+//
+// The following verbs are supported:
+//
+//   - %v, %+v, %s: the same as [Duration.String], e.g. "51h0m0s".
+//   - %q: the same as %s, double-quoted.
+//   - %d: the number of nanoseconds. Flags apply like for integers.
+//
+// A width pads the result with spaces, on the left or on the right with the '-' flag.
+//
+// Use [Duration.Humanize] to render the duration humanized.
+func (d Duration) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(d))
+	case 'v', 's':
+		writePadded(f, d.String())
+	case 'q':
+		writePadded(f, strconv.Quote(d.String()))
+	default:
+		fmt.Fprintf(f, "%%!%c(strfmt.Duration=%s)", verb, d.String())
+	}
+}
+
+// Humanize renders the duration with the units known to [ParseDuration] and at most the given number of units,
+// e.g. "2 days 3 hours". All the units are used when units <= 0.
+//
+// Humanized durations parse back with [ParseDuration], although possibly truncated by the number of units.
+func (d Duration) Humanize(units int) string {
+	return humanizeDuration(time.Duration(d), units)
+}
+
+// humanizeDuration renders a duration with at most the given number of units, e.g. "2 days 3 hours",
+// or with all the units when units <= 0.
+//
+// The remainder which does not fit in these units is truncated.
+func humanizeDuration(d time.Duration, units int) string {
+	var b strings.Builder
+	remainder := uint64(d) //nolint:gosec // the sign is handled below
+	if d < 0 {
+		b.WriteByte('-')
+		remainder = uint64(-d) //nolint:gosec // the minimum duration negates to itself, which converts to its magnitude
+	}
+
+	if units <= 0 {
+		units = len(humanUnits)
+	}
+
+	written := 0
+	for _, u := range humanUnits {
+		if written >= units {
+			break
+		}
+		n := remainder / u.unit
+		if n == 0 {
+			continue
+		}
+		remainder -= n * u.unit
+
+		if written > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(strconv.FormatUint(n, decimalBase))
+		b.WriteByte(' ')
+		if n == 1 {
+			b.WriteString(u.singular)
+		} else {
+			b.WriteString(u.plural)
+		}
+		written++
+	}
+
+	if written == 0 {
+		return "0 seconds"
+	}
+
+	return b.String()
+}
+
+// humanizeSince renders the time t relative to now, e.g. "3 minutes ago" or "in 2 days".
+//
+// Differences shorter than a second render as "now".
+func humanizeSince(t, now time.Time, units int) string {
+	elapsed := now.Sub(t)
+	switch {
+	case elapsed > -time.Second && elapsed < time.Second:
+		return "now"
+	case elapsed > 0:
+		return humanizeDuration(elapsed.Truncate(time.Second), units) + " ago"
+	default:
+		return "in " + humanizeDuration((-elapsed).Truncate(time.Second), units)
+	}
+}
+
+// writePadded writes s, padded with spaces to the width of f, if any.
+func writePadded(f fmt.State, s string) {
+	width, ok := f.Width()
+	if !ok || utf8.RuneCountInString(s) >= width {
+		_, _ = io.WriteString(f, s)
+
+		return
+	}
+
+	padding := strings.Repeat(" ", width-utf8.RuneCountInString(s))
+	if f.Flag('-') {
+		_, _ = io.WriteString(f, s+padding)
+	} else {
+		_, _ = io.WriteString(f, padding+s)
+	}
+}
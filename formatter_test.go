@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+var (
+	_ fmt.Formatter = DateTime{}
+	_ fmt.Formatter = Duration(0)
+)
+
+func TestDateTime_Format(t *testing.T) {
+	dt := DateTime(time.Date(2024, time.May, 1, 10, 7, 30, 123456789, time.UTC))
+
+	for _, tc := range []struct {
+		format   string
+		expected string
+	}{
+		{"%v", "2024-05-01T10:07:30.123Z"},
+		{"%+v", "2024-05-01T10:07:30.123Z"},
+		{"%+.0s", "2024-05-01T10:07:30Z"},
+		{"%s", "2024-05-01T10:07:30.123Z"},
+		{"%.0v", "2024-05-01T10:07:30Z"},
+		{"%.6s", "2024-05-01T10:07:30.123456Z"},
+		{"%.12s", "2024-05-01T10:07:30.123456789Z"},
+		{"%q", `"2024-05-01T10:07:30.123Z"`},
+		{"%.0q", `"2024-05-01T10:07:30Z"`},
+		{"%d", "1714558050"},
+		{"%12d", "  1714558050"},
+		{"%22.0v|", "  2024-05-01T10:07:30Z|"},
+		{"%-22.0v|", "2024-05-01T10:07:30Z  |"},
+		{"%x", "%!x(strfmt.DateTime=2024-05-01T10:07:30.123Z)"},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			assert.EqualT(t, tc.expected, fmt.Sprintf(tc.format, dt))
+		})
+	}
+
+	t.Run("should print struct fields like String", func(t *testing.T) {
+		model := struct {
+			Created DateTime
+			TTL     Duration
+		}{dt, Duration(51 * time.Hour)}
+		assert.EqualT(t, "{Created:2024-05-01T10:07:30.123Z TTL:51h0m0s}", fmt.Sprintf("%+v", model))
+	})
+}
+
+func TestDateTime_Since(t *testing.T) {
+	now := time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)
+
+	past := DateTime(now.Add(-3*time.Minute - 10*time.Second))
+	assert.EqualT(t, "3 minutes ago", past.Since(now, 1))
+	assert.EqualT(t, "3 minutes 10 seconds ago", past.Since(now, 2))
+	assert.EqualT(t, "3 minutes 10 seconds ago", past.Since(now, 0))
+
+	future := DateTime(now.Add(49*time.Hour + time.Minute))
+	assert.EqualT(t, "in 2 days", future.Since(now, 1))
+}
+
+func TestDate_Format(t *testing.T) {
+	d := Date(time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.EqualT(t, "Wednesday, May 1 2024", d.Format("Monday, January 2 2006"))
+	assert.EqualT(t, "01/05/24 00:00", d.Format("02/01/06 15:04"))
+	assert.EqualT(t, "2024-05-01", fmt.Sprintf("%v", d))
+}
+
+func TestDuration_Format(t *testing.T) {
+	d := Duration(2*24*time.Hour + 3*time.Hour + 500*time.Millisecond)
+
+	for _, tc := range []struct {
+		format   string
+		expected string
+	}{
+		{"%v", "51h0m0.5s"},
+		{"%s", "51h0m0.5s"},
+		{"%+v", "51h0m0.5s"},
+		{"%+.1s", "51h0m0.5s"},
+		{"%q", `"51h0m0.5s"`},
+		{"%d", "183600500000000"},
+		{"%12v|", "   51h0m0.5s|"},
+		{"%-12v|", "51h0m0.5s   |"},
+		{"%x", "%!x(strfmt.Duration=51h0m0.5s)"},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			assert.EqualT(t, tc.expected, fmt.Sprintf(tc.format, d))
+		})
+	}
+}
+
+func TestDuration_Humanize(t *testing.T) {
+	d := Duration(2*24*time.Hour + 3*time.Hour + 500*time.Millisecond)
+
+	assert.EqualT(t, "2 days 3 hours 500 milliseconds", d.Humanize(0))
+	assert.EqualT(t, "2 days 3 hours", d.Humanize(2))
+	assert.EqualT(t, "2 days", d.Humanize(1))
+}
+
+func TestHumanizeDuration(t *testing.T) {
+	for _, tc := range []struct {
+		duration time.Duration
+		expected string
+	}{
+		{0, "0 seconds"},
+		{time.Nanosecond, "1 nanosecond"},
+		{1500 * time.Microsecond, "1 millisecond 500 microseconds"},
+		{time.Minute, "1 minute"},
+		{-90 * time.Minute, "-1 hour 30 minutes"},
+		{10 * 24 * time.Hour, "1 week 3 days"},
+		{time.Duration(-1 << 63), "-15250 weeks 1 day 23 hours 47 minutes 16 seconds 854 milliseconds 775 microseconds 808 nanoseconds"},
+	} {
+		t.Run(tc.expected, func(t *testing.T) {
+			humanized := humanizeDuration(tc.duration, 0)
+			assert.EqualT(t, tc.expected, humanized)
+
+			parsed, err := ParseDuration(humanized)
+			require.NoError(t, err)
+			assert.EqualT(t, tc.duration, parsed)
+		})
+	}
+
+}
+
+func TestHumanUnits(t *testing.T) {
+	// the names of the humanized units must be aliases of the same units in timeMultiplier
+	for _, u := range humanUnits {
+		for _, name := range []string{u.singular, u.plural} {
+			unit, ok := timeMultiplier[name]
+			require.TrueTf(t, ok, "%q is not a unit known to ParseDuration", name)
+			assert.EqualTf(t, u.unit, unit, "%q is another unit for ParseDuration", name)
+		}
+	}
+}
+
+func TestHumanizeSince(t *testing.T) {
+	now := time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)
+
+	assert.EqualT(t, "now", humanizeSince(now.Add(-500*time.Millisecond), now, 1))
+	assert.EqualT(t, "1 second ago", humanizeSince(now.Add(-time.Second), now, 1))
+	assert.EqualT(t, "2 hours ago", humanizeSince(now.Add(-2*time.Hour-time.Minute), now, 1))
+	assert.EqualT(t, "in 1 week 1 day", humanizeSince(now.AddDate(0, 0, 8), now, 2))
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build ignore
+
+// gen_tznames generates tznames.go, the list of time zone names known to the time zone database
+// embedded in the Go toolchain (see package time/tzdata).
+//
+// Usage:
+//
+//	go run gen_tznames.go
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+)
+
+func main() {
+	out, err := exec.Command("go", "env", "GOROOT").Output()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	zipFile := filepath.Join(strings.TrimSpace(string(out)), "lib", "time", "zoneinfo.zip")
+	r, err := zip.OpenReader(zipFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	names := make([]string, 0, len(r.File))
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		names = append(names, f.Name)
+	}
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers\n")
+	buf.WriteString("// SPDX-License-Identifier: Apache-2.0\n\n")
+	fmt.Fprintf(&buf, "// Code generated by gen_tznames.go with %s. DO NOT EDIT.\n\n", runtime.Version())
+	buf.WriteString("package strfmt\n\n")
+	buf.WriteString("// timeZoneNames is the sorted list of the names known to the embedded time zone database,\n")
+	buf.WriteString("// including backward-compatible links and deprecated names.\n")
+	buf.WriteString("var timeZoneNames = [...]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q,\n", name)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("tznames.go", src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
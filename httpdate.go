@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+func init() { //nolint:gochecknoinits // registers http-date format in the default registry
+	d := HTTPDate{}
+	Default.Add("http-date", &d, IsHTTPDate)
+}
+
+const (
+	// IMFFixdate represents the preferred HTTP-date format of RFC 9110, section 5.6.7,
+	// a fixed-length subset of RFC 1123 dates, always in GMT, e.g. "Sun, 06 Nov 1994 08:49:37 GMT".
+	IMFFixdate = "Mon, 02 Jan 2006 15:04:05 GMT"
+	// RFC850Date represents the obsolete RFC 850 HTTP-date format, e.g. "Sunday, 06-Nov-94 08:49:37 GMT".
+	RFC850Date = "Monday, 02-Jan-06 15:04:05 GMT"
+	// ASCTimeDate represents the obsolete ANSI C asctime() HTTP-date format, e.g. "Sun Nov  6 08:49:37 1994".
+	ASCTimeDate = "Mon Jan _2 15:04:05 2006"
+
+	// rfc850Window is the number of years in the future past which an RFC 850 two-digit year
+	// is interpreted in the past (see RFC 9110, section 5.6.7).
+	rfc850Window    = 50
+	yearsPerCentury = 100
+)
+
+// IsHTTPDate returns true when the string is a valid HTTP-date, in any of the IMF-fixdate,
+// RFC 850 or asctime forms.
+func IsHTTPDate(str string) bool {
+	_, err := ParseHTTPDate(str)
+	return err == nil
+}
+
+// ParseHTTPDate parses a string that represents an HTTP-date, as used in HTTP headers such as
+// Last-Modified, Expires or Retry-After.
+//
+// All three forms defined by RFC 9110, section 5.6.7 are accepted: [IMFFixdate], [RFC850Date] and [ASCTimeDate].
+// The resulting [HTTPDate] is in UTC.
+//
+// The two-digit year of the RFC 850 form is interpreted as the year with the same last two digits which is at most
+// 50 years in the future.
+func ParseHTTPDate(data string) (HTTPDate, error) {
+	if t, err := time.Parse(IMFFixdate, data); err == nil {
+		return HTTPDate(t), nil
+	}
+
+	if t, err := time.Parse(RFC850Date, data); err == nil {
+		return HTTPDate(rfc850Year(t, time.Now().UTC().Year())), nil
+	}
+
+	if t, err := time.Parse(ASCTimeDate, data); err == nil {
+		return HTTPDate(t), nil
+	}
+
+	return HTTPDate{}, fmt.Errorf("invalid HTTP-date %q: %w", data, ErrFormat)
+}
+
+// rfc850Year resolves the two-digit year of an RFC 850 date relative to the current year:
+// the year is the one with the same last two digits which is at most 50 years in the future.
+func rfc850Year(t time.Time, currentYear int) time.Time {
+	year := currentYear/yearsPerCentury*yearsPerCentury + t.Year()%yearsPerCentury
+	switch {
+	case year > currentYear+rfc850Window:
+		year -= yearsPerCentury
+	case year <= currentYear+rfc850Window-yearsPerCentury:
+		year += yearsPerCentury
+	}
+
+	return time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// HTTPDate represents an HTTP-date, as used in HTTP headers, e.g. "Sun, 06 Nov 1994 08:49:37 GMT".
+//
+// It is parsed from any of the forms defined by RFC 9110, and always rendered as [IMFFixdate] in GMT.
+//
+// An HTTP-date has a precision of one second.
+//
+// swagger:strfmt http-date.
+type HTTPDate time.Time
+
+// HTTPDateOf returns the [HTTPDate] of a [DateTime], truncated to the second.
+func HTTPDateOf(t DateTime) HTTPDate {
+	return HTTPDate(time.Time(t).UTC().Truncate(time.Second))
+}
+
+// DateTime returns the [HTTPDate] as a [DateTime], in UTC.
+func (d HTTPDate) DateTime() DateTime {
+	return DateTime(time.Time(d).UTC())
+}
+
+// String converts this HTTP-date to a string, as [IMFFixdate].
+func (d HTTPDate) String() string {
+	return time.Time(d).UTC().Format(IMFFixdate)
+}
+
+// IsZero returns whether the HTTP-date is a zero value.
+func (d HTTPDate) IsZero() bool {
+	return time.Time(d).IsZero()
+}
+
+// Equal checks if two [HTTPDate] instances are the same instant.
+func (d HTTPDate) Equal(d2 HTTPDate) bool {
+	return time.Time(d).Equal(time.Time(d2))
+}
+
+// MarshalText serializes this HTTP-date to string.
+func (d HTTPDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses a text representation into an HTTP-date.
+func (d *HTTPDate) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = HTTPDate{}
+		return nil
+	}
+	v, err := ParseHTTPDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Scan scans a [HTTPDate] value from database driver type.
+func (d *HTTPDate) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return d.UnmarshalText(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case time.Time:
+		*d = HTTPDateOf(DateTime(v))
+		return nil
+	case nil:
+		*d = HTTPDate{}
+		return nil
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.HTTPDate from: %#v: %w", v, ErrFormat)
+	}
+}
+
+// Value converts [HTTPDate] to a primitive value ready to written to a database.
+func (d HTTPDate) Value() (driver.Value, error) {
+	return driver.Value(d.String()), nil
+}
+
+// MarshalJSON returns the [HTTPDate] as JSON.
+func (d HTTPDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON sets the [HTTPDate] from JSON.
+func (d *HTTPDate) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(str))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (d *HTTPDate) DeepCopyInto(out *HTTPDate) {
+	*out = *d
+}
+
+// DeepCopy copies the receiver into a new [HTTPDate].
+func (d *HTTPDate) DeepCopy() *HTTPDate {
+	if d == nil {
+		return nil
+	}
+	out := new(HTTPDate)
+	d.DeepCopyInto(out)
+	return out
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (d HTTPDate) GobEncode() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (d *HTTPDate) GobDecode(data []byte) error {
+	return d.UnmarshalText(data)
+}
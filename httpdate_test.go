@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+var (
+	_ sql.Scanner   = &HTTPDate{}
+	_ driver.Valuer = HTTPDate{}
+)
+
+func TestParseHTTPDate(t *testing.T) {
+	expected := time.Date(1994, time.November, 6, 8, 49, 37, 0, time.UTC)
+
+	for _, value := range []string{
+		"Sun, 06 Nov 1994 08:49:37 GMT",  // IMF-fixdate
+		"Sunday, 06-Nov-94 08:49:37 GMT", // RFC 850
+		"Sun Nov  6 08:49:37 1994",       // asctime
+	} {
+		t.Run(value, func(t *testing.T) {
+			d, err := ParseHTTPDate(value)
+			require.NoError(t, err)
+			assert.TrueT(t, expected.Equal(time.Time(d)))
+			assert.EqualT(t, "Sun, 06 Nov 1994 08:49:37 GMT", d.String())
+			assert.TrueT(t, IsHTTPDate(value))
+		})
+	}
+
+	for _, value := range []string{
+		"",
+		"Sun, 06 Nov 1994 08:49:37 UTC",
+		"Sun, 06 Nov 1994 08:49:37 +0000",
+		"Sun, 6 Nov 1994 08:49:37 GMT",
+		"1994-11-06T08:49:37Z",
+		"Sun, 31 Nov 1994 08:49:37 GMT",
+	} {
+		assert.FalseTf(t, IsHTTPDate(value), "expected %q to be invalid", value)
+		_, err := ParseHTTPDate(value)
+		require.ErrorIs(t, err, ErrFormat)
+	}
+}
+
+func TestHTTPDate_RFC850Year(t *testing.T) {
+	for _, tc := range []struct {
+		yy, current, expected int
+	}{
+		{94, 2026, 1994},
+		{26, 2026, 2026},
+		{76, 2026, 2076},
+		{0, 2026, 2000},
+		{77, 2026, 1977},
+		{5, 2099, 2105},
+	} {
+		parsed := time.Date(1900+tc.yy, time.March, 1, 0, 0, 0, 0, time.UTC)
+		assert.EqualTf(t, tc.expected, rfc850Year(parsed, tc.current).Year(), "year %02d in %d", tc.yy, tc.current)
+	}
+}
+
+func TestHTTPDate_DateTime(t *testing.T) {
+	paris := time.FixedZone("CEST", 2*60*60)
+	dt := DateTime(time.Date(2024, time.May, 1, 10, 0, 0, 123456789, paris))
+
+	d := HTTPDateOf(dt)
+	assert.EqualT(t, "Wed, 01 May 2024 08:00:00 GMT", d.String())
+	assert.EqualT(t, http.TimeFormat, IMFFixdate)
+	assert.EqualT(t, time.Time(dt).UTC().Format(http.TimeFormat), d.String())
+
+	back := d.DateTime()
+	assert.TrueT(t, back.Equal(DateTime(time.Date(2024, time.May, 1, 8, 0, 0, 0, time.UTC))))
+	assert.EqualT(t, time.UTC, time.Time(back).Location())
+}
+
+func TestHTTPDate_Serialization(t *testing.T) {
+	d, err := ParseHTTPDate("Sun, 06 Nov 1994 08:49:37 GMT")
+	require.NoError(t, err)
+
+	t.Run("with JSON", func(t *testing.T) {
+		b, err := d.MarshalJSON()
+		require.NoError(t, err)
+		assert.EqualT(t, `"Sun, 06 Nov 1994 08:49:37 GMT"`, string(b))
+
+		var out HTTPDate
+		require.NoError(t, out.UnmarshalJSON([]byte(`"Sunday, 06-Nov-94 08:49:37 GMT"`)))
+		assert.TrueT(t, d.Equal(out))
+
+		require.NoError(t, out.UnmarshalJSON([]byte(jsonNull)))
+		assert.TrueT(t, d.Equal(out))
+		require.Error(t, out.UnmarshalJSON([]byte(`"yesterday"`)))
+	})
+
+	t.Run("with Scan and Value", func(t *testing.T) {
+		for _, value := range []any{d.String(), []byte(d.String()), time.Time(d).Add(500 * time.Millisecond)} {
+			var out HTTPDate
+			require.NoError(t, out.Scan(value))
+			assert.TrueTf(t, d.Equal(out), "value: %#v", value)
+		}
+
+		var out HTTPDate
+		require.NoError(t, out.Scan(nil))
+		assert.TrueT(t, out.IsZero())
+		require.ErrorIs(t, out.Scan(int64(1)), ErrFormat)
+
+		v, err := d.Value()
+		require.NoError(t, err)
+		assert.EqualValues(t, "Sun, 06 Nov 1994 08:49:37 GMT", v)
+	})
+
+	t.Run("with BSON", func(t *testing.T) {
+		b, err := d.MarshalBSON()
+		require.NoError(t, err)
+
+		var out HTTPDate
+		require.NoError(t, out.UnmarshalBSON(b))
+		assert.TrueT(t, d.Equal(out))
+	})
+
+	t.Run("with registry", func(t *testing.T) {
+		assert.TrueT(t, Default.ContainsName("http-date"))
+		assert.TrueT(t, Default.Validates("http-date", "Sun Nov  6 08:49:37 1994"))
+		assert.FalseT(t, Default.Validates("http-date", "1994-11-06"))
+	})
+
+	t.Run("with DeepCopy", func(t *testing.T) {
+		out := d.DeepCopy()
+		assert.TrueT(t, d.Equal(*out))
+
+		var inNil *HTTPDate
+		assert.Nil(t, inNil.DeepCopy())
+	})
+}
@@ -28,5 +28,6 @@ type Registry interface {
 	ContainsName(name string) bool
 	Validates(name, data string) bool
 	Parse(name, data string) (any, error)
+	Canonicalize(name, data string) (string, error)
 	MapStructureHookFunc() mapstructure.DecodeHookFunc
 }
@@ -5,7 +5,7 @@
 //
 // This codec produces BSON output compatible with go.mongodb.org/mongo-driver/v2
 // (v2.5.0). It handles only the exact BSON patterns used by strfmt:
-// single-key {"data": value} documents with string, DateTime, or ObjectID values.
+// single-key {"data": value} documents with string, DateTime, ObjectID or null values.
 //
 // This package is intended to provide a backward-compatible API to users of
 // go-openapi/strfmt. It is not intended to be maintained or to follow the
@@ -19,10 +19,10 @@ import "time"
 // Codec provides BSON document marshal/unmarshal for strfmt types.
 //
 // MarshalDoc encodes a single-key BSON document {"data": value}.
-// The value must be one of: string, time.Time, or [12]byte (ObjectID).
+// The value must be one of: string, time.Time, [12]byte (ObjectID), or nil (null).
 //
 // UnmarshalDoc decodes a BSON document and returns the "data" field's value.
-// Returns one of: string, time.Time, or [12]byte depending on the BSON type.
+// Returns one of: string, time.Time, [12]byte, or nil depending on the BSON type.
 type Codec interface {
 	MarshalDoc(value any) ([]byte, error)
 	UnmarshalDoc(data []byte) (any, error)
@@ -33,6 +33,11 @@ type Codec interface {
 //nolint:gochecknoglobals // replaceable codec, by design
 var C Codec = liteCodec{}
 
+// Lite returns the minimal codec of this package, which is active unless replaced.
+func Lite() Codec { //nolint:ireturn // returns the codec interface, by design
+	return liteCodec{}
+}
+
 // Replace swaps the active BSON codec with the provided implementation.
 // This is intended to be called from enable/mongodb's init().
 //
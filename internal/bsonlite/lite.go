@@ -25,6 +25,8 @@ func (liteCodec) MarshalDoc(value any) ([]byte, error) {
 		return marshalDateTimeDoc(v), nil
 	case [ObjectIDSize]byte:
 		return marshalObjectIDDoc(v), nil
+	case nil:
+		return marshalNullDoc(), nil
 	default:
 		return nil, fmt.Errorf("bsonlite: unsupported value type %T: %w", value, errUnsupportedType)
 	}
@@ -41,6 +43,7 @@ func (liteCodec) UnmarshalDoc(data []byte) (any, error) {
 // String:   int32(len+1) + bytes + 0x00
 // DateTime: int64 (LE, millis since epoch)
 // ObjectID: [12]byte
+// Null:     no value
 
 const dataKey = "data\x00"
 
@@ -119,6 +122,27 @@ func marshalObjectIDDoc(oid [ObjectIDSize]byte) []byte {
 	return buf
 }
 
+func marshalNullDoc() []byte {
+	// doc_size(4) + type(1) + key("data\0"=5) + doc_term(1)
+	const docSize = 4 + 1 + 5 + 1
+
+	buf := make([]byte, docSize)
+	pos := 0
+
+	binary.LittleEndian.PutUint32(buf[pos:], docSize)
+	pos += 4
+
+	buf[pos] = TypeNull
+	pos++
+
+	copy(buf[pos:], dataKey)
+	// pos += len(dataKey)
+
+	buf[docSize-1] = 0 // document terminator
+
+	return buf
+}
+
 var (
 	errUnsupportedType = errors.New("bsonlite: unsupported type")
 	errDocTooShort     = errors.New("bsonlite: document too short")
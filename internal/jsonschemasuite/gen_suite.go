@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build ignore
+
+// gen_suite copies the format cases of the JSON Schema test suite to testdata/draft2020-12/optional/format,
+// verbatim, for the formats supported by [strfmt.Default], and records the upstream commit in testdata/upstream.json.
+//
+// Usage:
+//
+//	go run gen_suite.go [-ref main]
+//
+// The ref is a branch, a tag or a commit of https://github.com/json-schema-org/JSON-Schema-Test-Suite.
+// Review the changes with git diff, then update testdata/deviations.json along the test results.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	repository = "json-schema-org/JSON-Schema-Test-Suite"
+	formatDir  = "tests/draft2020-12/optional/format"
+	outputDir  = "testdata/draft2020-12/optional/format"
+	recordFile = "testdata/upstream.json"
+)
+
+// upstream is the record of testdata/upstream.json, like [jsonschemasuite.Upstream].
+type upstream struct {
+	Repository string   `json:"repository"`
+	Path       string   `json:"path"`
+	Commit     string   `json:"commit"`
+	Files      []string `json:"files"`
+}
+
+func main() {
+	ref := flag.String("ref", "main", "the branch, tag or commit of the suite to copy")
+	flag.Parse()
+
+	commit, err := get("https://api.github.com/repos/"+repository+"/commits/"+*ref, "application/vnd.github.sha")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	listing, err := get("https://api.github.com/repos/"+repository+"/contents/"+formatDir+"?ref="+string(commit), "application/vnd.github+json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var entries []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(listing, &entries); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.RemoveAll(outputDir); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		format := strings.TrimSuffix(entry.Name, ".json")
+		if path.Ext(entry.Name) != ".json" || !strfmt.Default.ContainsName(format) {
+			continue
+		}
+
+		data, err := get("https://raw.githubusercontent.com/"+repository+"/"+string(commit)+"/"+formatDir+"/"+entry.Name, "")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(outputDir, entry.Name), data, 0o600); err != nil {
+			log.Fatal(err)
+		}
+		files = append(files, entry.Name)
+	}
+	slices.Sort(files)
+
+	record, err := json.MarshalIndent(upstream{
+		Repository: "https://github.com/" + repository,
+		Path:       formatDir,
+		Commit:     string(commit),
+		Files:      files,
+	}, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(recordFile, append(record, '\n'), 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func get(url, accept string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jsonschemasuite runs the format cases of the JSON Schema test suite against strfmt registries.
+//
+// The cases are embedded from testdata/draft2020-12/optional/format, a verbatim copy of the files of the suite
+// (https://github.com/json-schema-org/JSON-Schema-Test-Suite, tests/draft2020-12/optional/format) for the formats
+// supported by strfmt. The upstream commit they come from is recorded in testdata/upstream.json (see [Source]).
+//
+// To refresh them, run go generate (see gen_suite.go), review the changes with git diff,
+// then update testdata/deviations.json along the test results.
+//
+// Each case is reported as passing, failing or as a known deviation, i.e. a documented difference
+// between strfmt and the suite, listed in testdata/deviations.json.
+package jsonschemasuite
+
+//go:generate go run gen_suite.go
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+)
+
+//go:embed testdata/draft2020-12/optional/format/*.json
+var suite embed.FS
+
+//go:embed testdata/deviations.json
+var deviations []byte
+
+//go:embed testdata/upstream.json
+var upstream []byte
+
+// Status is the outcome of a case.
+type Status string
+
+const (
+	// Pass means that strfmt agrees with the suite.
+	Pass Status = "pass"
+
+	// Fail means that strfmt disagrees with the suite, and that this is not a known deviation.
+	Fail Status = "fail"
+
+	// Deviation means that strfmt disagrees with the suite, as documented.
+	Deviation Status = "deviation"
+
+	// Fixed means that a known deviation is gone: strfmt now agrees with the suite.
+	Fixed Status = "fixed"
+
+	// Skip means that the case does not apply: the format is not supported or the value is not a string.
+	Skip Status = "skip"
+)
+
+// Group is a group of cases of the suite, sharing a schema.
+type Group struct {
+	Description string `json:"description"`
+	Schema      struct {
+		Format string `json:"format"`
+	} `json:"schema"`
+	Tests []Test `json:"tests"`
+}
+
+// Test is a case of the suite.
+type Test struct {
+	Description string `json:"description"`
+	Data        any    `json:"data"`
+	Valid       bool   `json:"valid"`
+}
+
+// Result is the outcome of a case against a registry.
+type Result struct {
+	Format      string `json:"format"`
+	Description string `json:"description"`
+	Data        any    `json:"data"`
+	Valid       bool   `json:"valid"`
+	Status      Status `json:"status"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+// Report is the outcome of the suite against a registry.
+type Report struct {
+	Registry string         `json:"registry"`
+	Summary  map[Status]int `json:"summary"`
+	Results  []Result       `json:"results"`
+}
+
+// Upstream records where the embedded cases come from.
+//
+// An empty commit stands for cases which have not been copied from the suite by gen_suite.go yet.
+type Upstream struct {
+	Repository string   `json:"repository"`
+	Path       string   `json:"path"`
+	Commit     string   `json:"commit"`
+	Files      []string `json:"files"`
+}
+
+// Deviations lists the known deviations by registry, format and case description, with their reasons.
+type Deviations map[string]map[string]map[string]string
+
+// Registries returns the registries the suite runs against, by name:
+// "default" is [strfmt.NewFormats] and "strict" is [strfmt.NewStrictFormats].
+func Registries() map[string]strfmt.Registry {
+	return map[string]strfmt.Registry{
+		"default": strfmt.NewFormats(),
+		"strict":  strfmt.NewStrictFormats(),
+	}
+}
+
+// Load reads the embedded cases, sorted by format.
+func Load() ([]Group, error) {
+	files, err := fs.Glob(suite, "testdata/draft2020-12/optional/format/*.json")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var groups []Group
+	for _, file := range files {
+		data, err := suite.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var fileGroups []Group
+		if err := json.Unmarshal(data, &fileGroups); err != nil {
+			return nil, fmt.Errorf("%s: %w", path.Base(file), err)
+		}
+		groups = append(groups, fileGroups...)
+	}
+
+	return groups, nil
+}
+
+// Source reads the record of the upstream commit of the embedded cases.
+func Source() (Upstream, error) {
+	var source Upstream
+	if err := json.Unmarshal(upstream, &source); err != nil {
+		return Upstream{}, fmt.Errorf("upstream.json: %w", err)
+	}
+
+	return source, nil
+}
+
+// KnownDeviations reads the embedded known deviations.
+func KnownDeviations() (Deviations, error) {
+	var known Deviations
+	if err := json.Unmarshal(deviations, &known); err != nil {
+		return nil, fmt.Errorf("deviations.json: %w", err)
+	}
+
+	return known, nil
+}
+
+// Run checks the cases against a registry.
+func Run(name string, registry strfmt.Registry, groups []Group, known Deviations) Report {
+	report := Report{Registry: name, Summary: make(map[Status]int)}
+	for _, group := range groups {
+		format := group.Schema.Format
+		for _, test := range group.Tests {
+			result := Result{Format: format, Description: test.Description, Data: test.Data, Valid: test.Valid}
+			result.Reason = known[name][format][test.Description]
+			result.Status = check(registry, format, test, result.Reason != "")
+			report.Summary[result.Status]++
+			report.Results = append(report.Results, result)
+		}
+	}
+
+	return report
+}
+
+func check(registry strfmt.Registry, format string, test Test, deviates bool) Status {
+	data, ok := test.Data.(string)
+	if !ok || !registry.ContainsName(format) {
+		return Skip
+	}
+
+	switch agrees := registry.Validates(format, data) == test.Valid; {
+	case agrees && deviates:
+		return Fixed
+	case agrees:
+		return Pass
+	case deviates:
+		return Deviation
+	default:
+		return Fail
+	}
+}
+
+// String summarizes a result, e.g. for test logs.
+func (r Result) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s: %q", r.Format, r.Description, r.Data)
+	if r.Valid {
+		b.WriteString(" should be valid")
+	} else {
+		b.WriteString(" should be invalid")
+	}
+	if r.Reason != "" {
+		fmt.Fprintf(&b, " (known deviation: %s)", r.Reason)
+	}
+
+	return b.String()
+}
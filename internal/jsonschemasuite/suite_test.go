@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschemasuite
+
+import (
+	"encoding/json"
+	"flag"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+var reportFile = flag.String("report", "", "writes the JSON report of the suite to this file")
+
+func TestSuite(t *testing.T) {
+	groups, err := Load()
+	require.NoError(t, err)
+	require.NotEmpty(t, groups)
+	known, err := KnownDeviations()
+	require.NoError(t, err)
+
+	registries := Registries()
+	names := make([]string, 0, len(registries))
+	for name := range registries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	t.Run("deviations should match cases", func(t *testing.T) {
+		cases := make(map[string]bool)
+		for _, group := range groups {
+			for _, test := range group.Tests {
+				cases[group.Schema.Format+": "+test.Description] = true
+			}
+		}
+		for name, formats := range known {
+			require.Containsf(t, registries, name, "unknown registry in testdata/deviations.json")
+			for format, descriptions := range formats {
+				for description := range descriptions {
+					require.TrueTf(t, cases[format+": "+description], "unknown case in testdata/deviations.json: %s: %s", format, description)
+				}
+			}
+		}
+	})
+
+	reports := make([]Report, 0, len(names))
+	for _, name := range names {
+		report := Run(name, registries[name], groups, known)
+		reports = append(reports, report)
+
+		t.Run(name, func(t *testing.T) {
+			for _, result := range report.Results {
+				switch result.Status {
+				case Fail:
+					t.Errorf("regression: %v", result)
+				case Fixed:
+					t.Errorf("fixed, remove it from testdata/deviations.json: %v", result)
+				}
+			}
+			t.Logf("summary: %v", report.Summary)
+		})
+	}
+
+	if *reportFile == "" {
+		return
+	}
+	data, err := json.MarshalIndent(reports, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(*reportFile, data, 0o600))
+}
+
+func TestSource(t *testing.T) {
+	source, err := Source()
+	require.NoError(t, err)
+
+	files, err := fs.Glob(suite, "testdata/draft2020-12/optional/format/*.json")
+	require.NoError(t, err)
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		names = append(names, path.Base(file))
+	}
+	assert.Equal(t, source.Files, names, "testdata/upstream.json should list the embedded files")
+
+	for _, name := range names {
+		assert.TrueTf(t, strfmt.Default.ContainsName(strings.TrimSuffix(name, ".json")), "unsupported format: %s", name)
+	}
+
+	if source.Commit == "" {
+		t.Logf("the cases are not a verbatim copy of %s yet: refresh them with go generate", source.Repository)
+	}
+}
+
+func TestRun(t *testing.T) {
+	group := Group{Tests: []Test{
+		{Description: "valid", Data: "192.168.0.1", Valid: true},
+		{Description: "invalid", Data: "192.168.0.256", Valid: false},
+		{Description: "wrong expectation", Data: "10.0.0.1", Valid: false},
+		{Description: "documented", Data: "10.0.0.2", Valid: false},
+		{Description: "fixed", Data: "10.0.0.3", Valid: true},
+		{Description: "not a string", Data: 12.0, Valid: true},
+	}}
+	group.Schema.Format = "ipv4"
+	unsupported := Group{Tests: []Test{{Description: "unsupported", Data: "12:00:00", Valid: true}}}
+	unsupported.Schema.Format = "time"
+
+	report := Run("default", strfmt.NewFormats(), []Group{group, unsupported}, Deviations{
+		"default": {"ipv4": {"documented": "on purpose", "fixed": "on purpose"}},
+	})
+
+	statuses := make([]Status, 0, len(report.Results))
+	for _, result := range report.Results {
+		statuses = append(statuses, result.Status)
+	}
+	assert.Equal(t, []Status{Pass, Pass, Fail, Deviation, Fixed, Skip, Skip}, statuses)
+	assert.Equal(t, map[Status]int{Pass: 2, Fail: 1, Deviation: 1, Fixed: 1, Skip: 2}, report.Summary)
+	assert.EqualT(t, "on purpose", report.Results[3].Reason)
+	assert.EqualT(t, `ipv4: documented: "10.0.0.2" should be invalid (known deviation: on purpose)`, report.Results[3].String())
+}
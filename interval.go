@@ -0,0 +1,596 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() { //nolint:gochecknoinits // registers interval formats in the default registry
+	i := Interval{}
+	Default.Add("interval", &i, IsInterval)
+
+	r := RepeatingInterval{}
+	Default.Add("repeating-interval", &r, IsRepeatingInterval)
+}
+
+const (
+	intervalSeparator   = "/"
+	repeatingDesignator = 'R'
+)
+
+// IsInterval returns true when the string is a valid ISO 8601 time interval.
+func IsInterval(str string) bool {
+	_, err := ParseInterval(str)
+	return err == nil
+}
+
+// IsRepeatingInterval returns true when the string is a valid ISO 8601 repeating time interval.
+func IsRepeatingInterval(str string) bool {
+	_, err := ParseRepeatingInterval(str)
+	return err == nil
+}
+
+type intervalForm uint8
+
+const (
+	intervalStartEnd intervalForm = iota
+	intervalStartDuration
+	intervalDurationEnd
+)
+
+// Interval represents an ISO 8601 time interval.
+//
+// An interval may be expressed in one of the following forms:
+//
+//   - start and end, e.g. "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z"
+//   - start and duration, e.g. "2024-01-01/P1M"
+//   - duration and end, e.g. "PT1H/2024-01-01T00:00:00Z"
+//
+// Endpoints are either a [DateTime] or a [Date]. A [Date] endpoint stands for
+// midnight in [DefaultTimeLocation]. Durations follow the ISO 8601 designator
+// syntax and may use calendar components (years, months, weeks, days), which are
+// resolved against the endpoint they are applied to.
+//
+// An interval includes its start and excludes its end.
+//
+// The form used to express the interval is retained and used when marshaling.
+//
+// swagger:strfmt interval.
+type Interval struct {
+	start     time.Time
+	end       time.Time
+	period    period
+	form      intervalForm
+	startDate bool
+	endDate   bool
+}
+
+// NewInterval builds an [Interval] from its start and end.
+func NewInterval(start, end DateTime) Interval {
+	return Interval{
+		start: time.Time(start),
+		end:   time.Time(end),
+	}
+}
+
+// ParseInterval parses a string that represents an ISO 8601 time interval.
+func ParseInterval(data string) (Interval, error) {
+	left, right, found := strings.Cut(data, intervalSeparator)
+	if !found {
+		return Interval{}, intervalError(data, "expected a start and an end separated by a solidus")
+	}
+
+	var (
+		i   Interval
+		err error
+	)
+
+	switch {
+	case isPeriod(left) && isPeriod(right):
+		return Interval{}, intervalError(data, "an interval cannot be expressed with two durations")
+
+	case isPeriod(left):
+		i.form = intervalDurationEnd
+		if i.period, err = parsePeriod(left); err != nil {
+			return Interval{}, intervalError(data, err.Error())
+		}
+		if i.end, i.endDate, err = parseIntervalEndpoint(right); err != nil {
+			return Interval{}, intervalError(data, err.Error())
+		}
+		i.start, _ = i.period.addTo(i.end, -1) // a single period does not overflow
+
+	case isPeriod(right):
+		i.form = intervalStartDuration
+		if i.start, i.startDate, err = parseIntervalEndpoint(left); err != nil {
+			return Interval{}, intervalError(data, err.Error())
+		}
+		if i.period, err = parsePeriod(right); err != nil {
+			return Interval{}, intervalError(data, err.Error())
+		}
+		i.end, _ = i.period.addTo(i.start, 1) // a single period does not overflow
+
+	default:
+		i.form = intervalStartEnd
+		if i.start, i.startDate, err = parseIntervalEndpoint(left); err != nil {
+			return Interval{}, intervalError(data, err.Error())
+		}
+		if i.end, i.endDate, err = parseIntervalEndpoint(right); err != nil {
+			return Interval{}, intervalError(data, err.Error())
+		}
+	}
+
+	if i.end.Before(i.start) {
+		return Interval{}, intervalError(data, "the end of the interval precedes its start")
+	}
+
+	return i, nil
+}
+
+func parseIntervalEndpoint(data string) (time.Time, bool, error) {
+	if IsDate(data) {
+		d, err := time.ParseInLocation(RFC3339FullDate, data, DefaultTimeLocation)
+		return d, true, err
+	}
+
+	if data == "" {
+		return time.Time{}, false, fmt.Errorf("empty interval endpoint: %w", ErrFormat)
+	}
+
+	dt, err := ParseDateTime(data)
+	return time.Time(dt), false, err
+}
+
+func intervalError(data, msg string) error {
+	return fmt.Errorf("invalid interval %q: %s: %w", data, msg, ErrFormat)
+}
+
+// Start returns the start of the interval.
+func (i Interval) Start() DateTime {
+	return DateTime(i.start)
+}
+
+// End returns the end of the interval.
+func (i Interval) End() DateTime {
+	return DateTime(i.end)
+}
+
+// Duration returns the actual elapsed time between the start and the end of the interval.
+func (i Interval) Duration() Duration {
+	return Duration(i.end.Sub(i.start))
+}
+
+// Contains tells if the instant t lies within the interval.
+func (i Interval) Contains(t DateTime) bool {
+	tt := time.Time(t)
+
+	return !tt.Before(i.start) && tt.Before(i.end)
+}
+
+// Encloses tells if the other interval lies entirely within this interval.
+func (i Interval) Encloses(other Interval) bool {
+	return !other.start.Before(i.start) && !other.end.After(i.end)
+}
+
+// Overlaps tells if the two intervals share at least one instant.
+func (i Interval) Overlaps(other Interval) bool {
+	return i.start.Before(other.end) && other.start.Before(i.end)
+}
+
+// IsZero returns whether the interval is a zero value.
+func (i Interval) IsZero() bool {
+	return i.start.IsZero() && i.end.IsZero()
+}
+
+// Equal checks if two [Interval] instances span the same instants, regardless of how they are expressed.
+func (i Interval) Equal(other Interval) bool {
+	return i.start.Equal(other.start) && i.end.Equal(other.end)
+}
+
+// String converts this interval to a string.
+func (i Interval) String() string {
+	switch i.form {
+	case intervalStartDuration:
+		return formatIntervalEndpoint(i.start, i.startDate) + intervalSeparator + i.period.String()
+	case intervalDurationEnd:
+		return i.period.String() + intervalSeparator + formatIntervalEndpoint(i.end, i.endDate)
+	default:
+		return formatIntervalEndpoint(i.start, i.startDate) + intervalSeparator + formatIntervalEndpoint(i.end, i.endDate)
+	}
+}
+
+func formatIntervalEndpoint(t time.Time, isDate bool) string {
+	if isDate {
+		return Date(t).String()
+	}
+
+	return DateTime(t).String()
+}
+
+// MarshalText implements the text marshaler interface.
+func (i Interval) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaler interface.
+func (i *Interval) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*i = Interval{}
+		return nil
+	}
+	ii, err := ParseInterval(string(text))
+	if err != nil {
+		return err
+	}
+	*i = ii
+	return nil
+}
+
+// Scan scans an [Interval] value from database driver type.
+func (i *Interval) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return i.UnmarshalText(v)
+	case string:
+		return i.UnmarshalText([]byte(v))
+	case nil:
+		*i = Interval{}
+		return nil
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Interval from: %#v: %w", v, ErrFormat)
+	}
+}
+
+// Value converts [Interval] to a primitive value ready to written to a database.
+func (i Interval) Value() (driver.Value, error) {
+	return driver.Value(i.String()), nil
+}
+
+// MarshalJSON returns the [Interval] as JSON.
+func (i Interval) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON sets the [Interval] from JSON.
+func (i *Interval) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var istr string
+	if err := json.Unmarshal(data, &istr); err != nil {
+		return err
+	}
+	return i.UnmarshalText([]byte(istr))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (i *Interval) DeepCopyInto(out *Interval) {
+	*out = *i
+}
+
+// DeepCopy copies the receiver into a new [Interval].
+func (i *Interval) DeepCopy() *Interval {
+	if i == nil {
+		return nil
+	}
+	out := new(Interval)
+	i.DeepCopyInto(out)
+	return out
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (i Interval) GobEncode() ([]byte, error) {
+	return i.MarshalText()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (i *Interval) GobDecode(data []byte) error {
+	return i.UnmarshalText(data)
+}
+
+// nth returns the k-th repetition of this interval.
+//
+// Intervals expressed with a start repeat forward in time, whereas intervals
+// expressed with a duration and an end repeat backward in time.
+//
+// It reports false when the repetition lies beyond the range of time.
+func (i Interval) nth(k int) (Interval, bool) {
+	if k == 0 {
+		return i, true
+	}
+
+	next := i
+	var okStart, okEnd bool
+	switch i.form {
+	case intervalStartDuration:
+		next.start, okStart = i.period.addTo(i.start, k)
+		next.end, okEnd = i.period.addTo(i.start, k+1)
+	case intervalDurationEnd:
+		next.end, okEnd = i.period.addTo(i.end, -k)
+		next.start, okStart = i.period.addTo(i.end, -(k + 1))
+	default:
+		step := i.end.Sub(i.start)
+		next.start, okStart = addDurationTimes(i.start, step, k)
+		next.end, okEnd = addDurationTimes(i.end, step, k)
+	}
+	if !okStart || !okEnd {
+		return Interval{}, false
+	}
+	next.startDate = i.startDate && isMidnight(next.start)
+	next.endDate = i.endDate && isMidnight(next.end)
+
+	return next, true
+}
+
+// seconds returns the approximate length of this interval, in seconds.
+//
+// Calendar components count for their average length in the Gregorian calendar.
+func (i Interval) seconds() float64 {
+	const (
+		secondsInDay   = 24 * 60 * 60
+		daysInYear     = 365.2425
+		daysInMonth    = daysInYear / monthsPerYear
+		secondsInYear  = daysInYear * secondsInDay
+		secondsInMonth = daysInMonth * secondsInDay
+	)
+
+	if i.form == intervalStartEnd {
+		return secondsBetween(i.start, i.end)
+	}
+	p := i.period
+
+	return float64(p.years)*secondsInYear + float64(p.months)*secondsInMonth +
+		float64(p.weeks*daysInWeek+p.days)*secondsInDay + p.clock.Seconds()
+}
+
+func secondsBetween(from, to time.Time) float64 {
+	return float64(to.Unix()-from.Unix()) + float64(to.Nanosecond()-from.Nanosecond())/float64(time.Second)
+}
+
+func isMidnight(t time.Time) bool {
+	h, m, s := t.Clock()
+
+	return h == 0 && m == 0 && s == 0 && t.Nanosecond() == 0
+}
+
+// RepeatingInterval represents an ISO 8601 repeating time interval, e.g. "R5/2024-01-01T00:00:00Z/PT1H".
+//
+// The number of repetitions may be omitted (e.g. "R/2024-01-01/P1D"), in which case the interval repeats forever.
+//
+// Intervals expressed with a start repeat forward in time. Intervals expressed with a duration
+// and an end repeat backward in time, the first repetition being the one that ends at that end.
+//
+// swagger:strfmt repeating-interval.
+type RepeatingInterval struct {
+	interval    Interval
+	repetitions int
+}
+
+// NewRepeatingInterval builds a [RepeatingInterval] from an [Interval] and a number of repetitions.
+//
+// A negative number of repetitions stands for an unbounded repeating interval.
+func NewRepeatingInterval(interval Interval, repetitions int) RepeatingInterval {
+	if repetitions < 0 {
+		repetitions = -1
+	}
+
+	return RepeatingInterval{
+		interval:    interval,
+		repetitions: repetitions,
+	}
+}
+
+// ParseRepeatingInterval parses a string that represents an ISO 8601 repeating time interval.
+func ParseRepeatingInterval(data string) (RepeatingInterval, error) {
+	head, tail, found := strings.Cut(data, intervalSeparator)
+	if !found || len(head) == 0 || head[0] != repeatingDesignator {
+		return RepeatingInterval{}, fmt.Errorf("invalid repeating interval %q: expected a leading repetition designator: %w", data, ErrFormat)
+	}
+
+	repetitions := -1
+	if count := head[1:]; count != "" {
+		n, err := strconv.ParseUint(count, 10, 31)
+		if err != nil {
+			return RepeatingInterval{}, fmt.Errorf("invalid repeating interval %q: invalid number of repetitions: %w", data, ErrFormat)
+		}
+		repetitions = int(n)
+	}
+
+	interval, err := ParseInterval(tail)
+	if err != nil {
+		return RepeatingInterval{}, err
+	}
+
+	return RepeatingInterval{interval: interval, repetitions: repetitions}, nil
+}
+
+// Interval returns the first repetition of the repeating interval.
+func (r RepeatingInterval) Interval() Interval {
+	return r.interval
+}
+
+// Repetitions returns the number of repetitions, or -1 when the repeating interval is unbounded.
+func (r RepeatingInterval) Repetitions() int {
+	return r.repetitions
+}
+
+// IsUnbounded tells if the interval repeats forever.
+func (r RepeatingInterval) IsUnbounded() bool {
+	return r.repetitions < 0
+}
+
+// All iterates over all repetitions of the interval.
+//
+// The iteration never ends for an unbounded repeating interval: callers should break out of the loop.
+// It stops anyway when the next repetition lies beyond the range of time.
+func (r RepeatingInterval) All() iter.Seq[Interval] {
+	return func(yield func(Interval) bool) {
+		for k := 0; r.IsUnbounded() || k < r.repetitions; k++ {
+			interval, ok := r.interval.nth(k)
+			if !ok || !yield(interval) {
+				return
+			}
+		}
+	}
+}
+
+// Contains tells if the instant t lies within any of the repetitions of the interval.
+//
+// The repetition that may contain t is estimated from the length of the interval, then
+// looked up among its neighbors: the cost does not depend on how far t lies from the first repetition.
+func (r RepeatingInterval) Contains(t DateTime) bool {
+	tt := time.Time(t)
+	backward := r.interval.form == intervalDurationEnd
+
+	if r.repetitions == 0 || (!backward && tt.Before(r.interval.start)) || (backward && !tt.Before(r.interval.end)) {
+		return false
+	}
+
+	// distance from the first repetition, in the direction of the repetitions
+	distance := secondsBetween(r.interval.start, tt)
+	if backward {
+		distance = secondsBetween(tt, r.interval.end)
+	}
+
+	step := r.interval.seconds()
+	if step <= 0 {
+		// empty interval: repetitions do not progress
+		return r.interval.Contains(t)
+	}
+
+	const maxEstimate = 1 << 62
+	k := int(min(distance/step, maxEstimate))
+	if !r.IsUnbounded() {
+		k = min(k, r.repetitions-1)
+	}
+	for {
+		interval, ok := r.interval.nth(k)
+		if !ok {
+			return false
+		}
+
+		switch {
+		case interval.Contains(t):
+			return r.IsUnbounded() || k < r.repetitions
+		case k > 0 && !backward && interval.start.After(tt), k > 0 && backward && !interval.end.After(tt):
+			// overshot: t lies within an earlier repetition
+			k--
+		case !r.IsUnbounded() && k >= r.repetitions-1:
+			// t lies beyond the last repetition
+			return false
+		default:
+			k++
+		}
+	}
+}
+
+// IsZero returns whether the repeating interval is a zero value.
+func (r RepeatingInterval) IsZero() bool {
+	return r.repetitions == 0 && r.interval.IsZero()
+}
+
+// Equal checks if two [RepeatingInterval] instances are equal.
+func (r RepeatingInterval) Equal(other RepeatingInterval) bool {
+	return r.repetitions == other.repetitions && r.interval.Equal(other.interval)
+}
+
+// String converts this repeating interval to a string.
+func (r RepeatingInterval) String() string {
+	var w strings.Builder
+	w.WriteByte(repeatingDesignator)
+	if !r.IsUnbounded() {
+		w.WriteString(strconv.Itoa(r.repetitions))
+	}
+	w.WriteString(intervalSeparator)
+	w.WriteString(r.interval.String())
+
+	return w.String()
+}
+
+// MarshalText implements the text marshaler interface.
+func (r RepeatingInterval) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaler interface.
+func (r *RepeatingInterval) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = RepeatingInterval{}
+		return nil
+	}
+	rr, err := ParseRepeatingInterval(string(text))
+	if err != nil {
+		return err
+	}
+	*r = rr
+	return nil
+}
+
+// Scan scans a [RepeatingInterval] value from database driver type.
+func (r *RepeatingInterval) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return r.UnmarshalText(v)
+	case string:
+		return r.UnmarshalText([]byte(v))
+	case nil:
+		*r = RepeatingInterval{}
+		return nil
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.RepeatingInterval from: %#v: %w", v, ErrFormat)
+	}
+}
+
+// Value converts [RepeatingInterval] to a primitive value ready to written to a database.
+func (r RepeatingInterval) Value() (driver.Value, error) {
+	return driver.Value(r.String()), nil
+}
+
+// MarshalJSON returns the [RepeatingInterval] as JSON.
+func (r RepeatingInterval) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON sets the [RepeatingInterval] from JSON.
+func (r *RepeatingInterval) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var rstr string
+	if err := json.Unmarshal(data, &rstr); err != nil {
+		return err
+	}
+	return r.UnmarshalText([]byte(rstr))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (r *RepeatingInterval) DeepCopyInto(out *RepeatingInterval) {
+	*out = *r
+}
+
+// DeepCopy copies the receiver into a new [RepeatingInterval].
+func (r *RepeatingInterval) DeepCopy() *RepeatingInterval {
+	if r == nil {
+		return nil
+	}
+	out := new(RepeatingInterval)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (r RepeatingInterval) GobEncode() ([]byte, error) {
+	return r.MarshalText()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (r *RepeatingInterval) GobDecode(data []byte) error {
+	return r.UnmarshalText(data)
+}
@@ -0,0 +1,444 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+var (
+	_ sql.Scanner   = &Interval{}
+	_ driver.Valuer = Interval{}
+	_ sql.Scanner   = &RepeatingInterval{}
+	_ driver.Valuer = RepeatingInterval{}
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		in    string
+		start time.Time
+		end   time.Time
+		str   string
+	}{
+		{
+			in:    "2007-03-01T13:00:00Z/2008-05-11T15:30:00Z",
+			start: time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+			end:   time.Date(2008, 5, 11, 15, 30, 0, 0, time.UTC),
+			str:   "2007-03-01T13:00:00.000Z/2008-05-11T15:30:00.000Z",
+		},
+		{
+			in:    "2007-03-01T13:00:00Z/P1Y2M10DT2H30M",
+			start: time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+			end:   time.Date(2008, 5, 11, 15, 30, 0, 0, time.UTC),
+			str:   "2007-03-01T13:00:00.000Z/P1Y2M10DT2H30M",
+		},
+		{
+			in:    "P1Y2M10DT2H30M/2008-05-11T15:30:00Z",
+			start: time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+			end:   time.Date(2008, 5, 11, 15, 30, 0, 0, time.UTC),
+			str:   "P1Y2M10DT2H30M/2008-05-11T15:30:00.000Z",
+		},
+		{
+			in:    "2024-01-01/P1M",
+			start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			str:   "2024-01-01/P1M",
+		},
+		{
+			in:    "2024-01-01/2024-03-01",
+			start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			str:   "2024-01-01/2024-03-01",
+		},
+		{
+			in:    "2024-01-01T10:00:00+02:00/P2W",
+			start: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC),
+			str:   "2024-01-01T10:00:00.000+02:00/P2W",
+		},
+		{
+			in:    "2024-01-01T00:00:00Z/PT1.5S",
+			start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 1, 1, 0, 0, 1, 5e8, time.UTC),
+			str:   "2024-01-01T00:00:00.000Z/PT1.5S",
+		},
+		{
+			in:    "2024-01-01T00:00:00Z/PT0S",
+			start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			str:   "2024-01-01T00:00:00.000Z/PT0S",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			interval, err := ParseInterval(tt.in)
+			require.NoError(t, err)
+			assert.TrueT(t, IsInterval(tt.in))
+
+			assert.TrueT(t, time.Time(interval.Start()).Equal(tt.start))
+			assert.TrueT(t, time.Time(interval.End()).Equal(tt.end))
+			assert.EqualT(t, tt.end.Sub(tt.start), time.Duration(interval.Duration()))
+			assert.EqualT(t, tt.str, interval.String())
+
+			// round trip
+			again, err := ParseInterval(interval.String())
+			require.NoError(t, err)
+			assert.TrueT(t, interval.Equal(again))
+			assert.EqualT(t, tt.str, again.String())
+		})
+	}
+}
+
+func TestParseInterval_errorCases(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"2024-01-01",
+		"2024-01-01/",
+		"/2024-01-01",
+		"P1D/P1D",
+		"2024-02-01/2024-01-01",
+		"2024-01-01/yada",
+		"yada/2024-01-01",
+		"2024-01-01/P",
+		"2024-01-01/PT",
+		"2024-01-01/P1",
+		"2024-01-01/P1.5D",
+		"2024-01-01/P1D2Y",
+		"2024-01-01/PT1H2H",
+		"2024-01-01/PT1.5H30M",
+		"2024-01-01/PT1.H",
+		"2024-01-01/P1X",
+		"2024-01-01/PT1D",
+		"2024-01-01/P99999999999Y",
+		"2024-01-01/PT9999999999999999999999H",
+	} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseInterval(in)
+			require.Error(t, err)
+			require.ErrorIs(t, err, ErrFormat)
+			assert.FalseT(t, IsInterval(in))
+		})
+	}
+}
+
+func TestInterval_Relations(t *testing.T) {
+	q1, err := ParseInterval("2024-01-01/P3M")
+	require.NoError(t, err)
+	feb, err := ParseInterval("2024-02-01/P1M")
+	require.NoError(t, err)
+	q2, err := ParseInterval("2024-04-01/P3M")
+	require.NoError(t, err)
+	straddle, err := ParseInterval("2024-03-15/2024-04-15")
+	require.NoError(t, err)
+
+	t.Run("Contains should include the start and exclude the end", func(t *testing.T) {
+		assert.TrueT(t, q1.Contains(q1.Start()))
+		assert.TrueT(t, q1.Contains(DateTime(time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC))))
+		assert.FalseT(t, q1.Contains(q1.End()))
+		assert.FalseT(t, q1.Contains(DateTime(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC))))
+	})
+
+	t.Run("Encloses", func(t *testing.T) {
+		assert.TrueT(t, q1.Encloses(feb))
+		assert.TrueT(t, q1.Encloses(q1))
+		assert.FalseT(t, feb.Encloses(q1))
+		assert.FalseT(t, q1.Encloses(straddle))
+	})
+
+	t.Run("Overlaps", func(t *testing.T) {
+		assert.TrueT(t, q1.Overlaps(feb))
+		assert.TrueT(t, feb.Overlaps(q1))
+		assert.TrueT(t, q1.Overlaps(straddle))
+		assert.TrueT(t, q2.Overlaps(straddle))
+		assert.FalseT(t, q1.Overlaps(q2), "adjacent intervals do not overlap")
+		assert.FalseT(t, q2.Overlaps(q1), "adjacent intervals do not overlap")
+	})
+
+	t.Run("Equal should compare instants, not forms", func(t *testing.T) {
+		other, err := ParseInterval("2024-01-01T00:00:00Z/2024-04-01T00:00:00Z")
+		require.NoError(t, err)
+		assert.TrueT(t, q1.Equal(other))
+		assert.NotEqualT(t, q1.String(), other.String())
+		assert.FalseT(t, q1.Equal(q2))
+	})
+
+	t.Run("NewInterval", func(t *testing.T) {
+		i := NewInterval(q1.Start(), q1.End())
+		assert.TrueT(t, i.Equal(q1))
+		assert.EqualT(t, "2024-01-01T00:00:00.000Z/2024-04-01T00:00:00.000Z", i.String())
+		assert.FalseT(t, i.IsZero())
+		assert.TrueT(t, Interval{}.IsZero())
+	})
+}
+
+func TestInterval_Encoding(t *testing.T) {
+	const orig = "2024-01-01/P1M"
+	bj := []byte(`"` + orig + `"`)
+
+	var i Interval
+	require.NoError(t, i.UnmarshalText([]byte(orig)))
+	txt, err := i.MarshalText()
+	require.NoError(t, err)
+	assert.EqualT(t, orig, string(txt))
+
+	require.Error(t, i.UnmarshalText([]byte("yada")))
+	require.NoError(t, i.UnmarshalText([]byte{}))
+	assert.TrueT(t, i.IsZero())
+	require.NoError(t, i.UnmarshalText([]byte(orig)))
+
+	var j Interval
+	require.NoError(t, j.UnmarshalJSON(bj))
+	assert.TrueT(t, i.Equal(j))
+	assert.JSONMarshalAsT(t, string(bj), j)
+	require.NoError(t, j.UnmarshalJSON([]byte(jsonNull)))
+	assert.TrueT(t, i.Equal(j))
+	require.Error(t, j.UnmarshalJSON([]byte(`"yada"`)))
+	require.Error(t, j.UnmarshalJSON([]byte(`yada`)))
+
+	for _, value := range []any{orig, []byte(orig)} {
+		var k Interval
+		require.NoError(t, k.Scan(value))
+		assert.EqualT(t, orig, k.String())
+	}
+
+	var k Interval
+	require.NoError(t, k.Scan(nil))
+	assert.TrueT(t, k.IsZero())
+	require.Error(t, k.Scan(123))
+
+	v, err := i.Value()
+	require.NoError(t, err)
+	assert.EqualValues(t, orig, v)
+
+	testValid(t, "interval", orig)
+	testInvalid(t, "interval", "2024-01-01")
+
+	parsed, err := Default.Parse("interval", orig)
+	require.NoError(t, err)
+	require.IsType(t, &Interval{}, parsed)
+}
+
+func TestDeepCopyInterval(t *testing.T) {
+	i, err := ParseInterval("2024-01-01/P1M")
+	require.NoError(t, err)
+	in := &i
+
+	out := new(Interval)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *Interval
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
+func TestParseRepeatingInterval(t *testing.T) {
+	t.Run("with bounded repetitions", func(t *testing.T) {
+		const orig = "R5/2024-01-01T00:00:00.000Z/PT1H"
+		r, err := ParseRepeatingInterval(orig)
+		require.NoError(t, err)
+		assert.TrueT(t, IsRepeatingInterval(orig))
+		assert.EqualT(t, 5, r.Repetitions())
+		assert.FalseT(t, r.IsUnbounded())
+		assert.EqualT(t, orig, r.String())
+
+		starts := make([]string, 0, 5)
+		for interval := range r.All() {
+			starts = append(starts, interval.Start().String())
+			assert.EqualT(t, time.Hour, time.Duration(interval.Duration()))
+		}
+		assert.Equal(t, []string{
+			"2024-01-01T00:00:00.000Z",
+			"2024-01-01T01:00:00.000Z",
+			"2024-01-01T02:00:00.000Z",
+			"2024-01-01T03:00:00.000Z",
+			"2024-01-01T04:00:00.000Z",
+		}, starts)
+
+		assert.TrueT(t, r.Contains(DateTime(time.Date(2024, 1, 1, 4, 30, 0, 0, time.UTC))))
+		assert.FalseT(t, r.Contains(DateTime(time.Date(2024, 1, 1, 5, 0, 0, 0, time.UTC))))
+		assert.FalseT(t, r.Contains(DateTime(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC))))
+	})
+
+	t.Run("with unbounded calendar repetitions", func(t *testing.T) {
+		const orig = "R/2024-01-31/P1M"
+		r, err := ParseRepeatingInterval(orig)
+		require.NoError(t, err)
+		assert.TrueT(t, r.IsUnbounded())
+		assert.EqualT(t, -1, r.Repetitions())
+		assert.EqualT(t, orig, r.String())
+
+		var got []string
+		for interval := range r.All() {
+			if len(got) == 3 {
+				break
+			}
+			got = append(got, interval.String())
+		}
+		// repetitions are computed from the origin, so month-end overflows do not accumulate
+		assert.Equal(t, []string{"2024-01-31/P1M", "2024-03-02/P1M", "2024-03-31/P1M"}, got)
+
+		assert.TrueT(t, r.Contains(DateTime(time.Date(2030, 6, 15, 0, 0, 0, 0, time.UTC))))
+		assert.FalseT(t, r.Contains(DateTime(time.Date(2020, 6, 15, 0, 0, 0, 0, time.UTC))))
+	})
+
+	t.Run("with duration and end, repetitions go backward", func(t *testing.T) {
+		r, err := ParseRepeatingInterval("R3/P1D/2024-01-10")
+		require.NoError(t, err)
+
+		got := slices.Collect(r.All())
+		require.Len(t, got, 3)
+		assert.EqualT(t, "P1D/2024-01-10", got[0].String())
+		assert.EqualT(t, "P1D/2024-01-09", got[1].String())
+		assert.EqualT(t, "P1D/2024-01-08", got[2].String())
+
+		assert.TrueT(t, r.Contains(DateTime(time.Date(2024, 1, 7, 12, 0, 0, 0, time.UTC))))
+		assert.FalseT(t, r.Contains(DateTime(time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC))))
+		assert.FalseT(t, r.Contains(DateTime(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))))
+	})
+
+	t.Run("with start and end, repetitions use the elapsed time", func(t *testing.T) {
+		r, err := ParseRepeatingInterval("R2/2024-01-01/2024-01-03")
+		require.NoError(t, err)
+
+		got := slices.Collect(r.All())
+		require.Len(t, got, 2)
+		assert.EqualT(t, "2024-01-03/2024-01-05", got[1].String())
+	})
+
+	t.Run("with empty unbounded interval", func(t *testing.T) {
+		r, err := ParseRepeatingInterval("R/2024-01-01T00:00:00Z/PT0S")
+		require.NoError(t, err)
+		assert.FalseT(t, r.Contains(DateTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))))
+	})
+
+	t.Run("with zero repetitions", func(t *testing.T) {
+		r, err := ParseRepeatingInterval("R0/2024-01-01/P1D")
+		require.NoError(t, err)
+		assert.Empty(t, slices.Collect(r.All()))
+	})
+
+	t.Run("Contains should look up far repetitions directly", func(t *testing.T) {
+		seconds, err := ParseRepeatingInterval("R2000000000/2000-01-01T00:00:00Z/PT2S")
+		require.NoError(t, err)
+		assert.TrueT(t, seconds.Contains(DateTime(time.Date(2126, 6, 15, 12, 34, 56, 500, time.UTC))))
+		assert.FalseT(t, seconds.Contains(DateTime(time.Date(2127, 1, 1, 0, 0, 0, 0, time.UTC))))
+
+		months, err := ParseRepeatingInterval("R1200/2000-01-31/P1M")
+		require.NoError(t, err)
+		assert.TrueT(t, months.Contains(DateTime(time.Date(2050, 2, 28, 0, 0, 0, 0, time.UTC))))
+		assert.TrueT(t, months.Contains(DateTime(time.Date(2100, 1, 30, 23, 59, 59, 0, time.UTC))))
+		assert.FalseT(t, months.Contains(DateTime(time.Date(2100, 1, 31, 0, 0, 0, 0, time.UTC))))
+
+		weeks, err := ParseRepeatingInterval("R5200/P1W/2024-01-01")
+		require.NoError(t, err)
+		assert.TrueT(t, weeks.Contains(DateTime(time.Date(1925, 1, 1, 0, 0, 0, 0, time.UTC))))
+		assert.FalseT(t, weeks.Contains(DateTime(time.Date(1924, 1, 1, 0, 0, 0, 0, time.UTC))))
+
+		unbounded, err := ParseRepeatingInterval("R/2000-01-01T00:00:00Z/2000-01-01T01:00:00Z")
+		require.NoError(t, err)
+		assert.TrueT(t, unbounded.Contains(DateTime(time.Date(9999, 12, 31, 23, 0, 0, 0, time.UTC))))
+	})
+
+	t.Run("repetitions should not wrap around beyond the range of durations", func(t *testing.T) {
+		i, err := ParseInterval("2000-01-01T00:00:00Z/2000-01-01T01:00:00Z")
+		require.NoError(t, err)
+
+		// k * 1 hour exceeds the range of a time.Duration from k = 2562048 on
+		previous, ok := i.nth(2562047)
+		require.TrueT(t, ok)
+		for k := 2562048; k < 2562051; k++ {
+			next, ok := i.nth(k)
+			require.TrueT(t, ok)
+			assert.TrueTf(t, next.start.Equal(previous.end), "repetition %d should follow the previous one", k)
+			previous = next
+		}
+		assert.EqualT(t, 2292, previous.start.Year())
+
+		_, ok = i.nth(math.MaxInt)
+		assert.FalseT(t, ok, "repetitions beyond the range of time should not be computed")
+	})
+
+	t.Run("NewRepeatingInterval", func(t *testing.T) {
+		i, err := ParseInterval("2024-01-01/P1D")
+		require.NoError(t, err)
+		r := NewRepeatingInterval(i, -10)
+		assert.TrueT(t, r.IsUnbounded())
+		assert.EqualT(t, "R/2024-01-01/P1D", r.String())
+		assert.TrueT(t, r.Interval().Equal(i))
+		assert.TrueT(t, r.Equal(r))
+		assert.FalseT(t, r.Equal(NewRepeatingInterval(i, 2)))
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, in := range []string{
+			"",
+			"R",
+			"R5",
+			"5/2024-01-01/P1D",
+			"R-1/2024-01-01/P1D",
+			"Rx/2024-01-01/P1D",
+			"R5/2024-01-01",
+			"R99999999999/2024-01-01/P1D",
+		} {
+			_, err := ParseRepeatingInterval(in)
+			require.Error(t, err, "expected %q to be invalid", in)
+			assert.FalseT(t, IsRepeatingInterval(in))
+		}
+	})
+}
+
+func TestRepeatingInterval_Encoding(t *testing.T) {
+	const orig = "R5/2024-01-01/P1D"
+	bj := []byte(`"` + orig + `"`)
+
+	var r RepeatingInterval
+	require.NoError(t, r.UnmarshalText([]byte(orig)))
+	txt, err := r.MarshalText()
+	require.NoError(t, err)
+	assert.EqualT(t, orig, string(txt))
+	require.Error(t, r.UnmarshalText([]byte("yada")))
+
+	var s RepeatingInterval
+	require.NoError(t, s.UnmarshalJSON(bj))
+	assert.TrueT(t, r.Equal(s))
+	assert.JSONMarshalAsT(t, string(bj), s)
+	require.NoError(t, s.UnmarshalJSON([]byte(jsonNull)))
+	require.Error(t, s.UnmarshalJSON([]byte(`"yada"`)))
+
+	for _, value := range []any{orig, []byte(orig)} {
+		var k RepeatingInterval
+		require.NoError(t, k.Scan(value))
+		assert.EqualT(t, orig, k.String())
+	}
+
+	var k RepeatingInterval
+	require.NoError(t, k.Scan(nil))
+	assert.TrueT(t, k.IsZero())
+	require.Error(t, k.Scan(123))
+
+	v, err := r.Value()
+	require.NoError(t, err)
+	assert.EqualValues(t, orig, v)
+
+	testValid(t, "repeating-interval", orig)
+	testInvalid(t, "repeating-interval", "2024-01-01/P1D")
+
+	out := r.DeepCopy()
+	assert.Equal(t, &r, out)
+	var inNil *RepeatingInterval
+	assert.Nil(t, inNil.DeepCopy())
+}
@@ -43,6 +43,20 @@ var (
 	_ bsonUnmarshaler = (*Duration)(nil)
 	_ bsonMarshaler   = DateTime{}
 	_ bsonUnmarshaler = &DateTime{}
+	_ bsonMarshaler   = ExactDateTime{}
+	_ bsonUnmarshaler = &ExactDateTime{}
+	_ bsonMarshaler   = ZonedDateTime{}
+	_ bsonUnmarshaler = &ZonedDateTime{}
+	_ bsonMarshaler   = CivilDate{}
+	_ bsonUnmarshaler = &CivilDate{}
+	_ bsonMarshaler   = HTTPDate{}
+	_ bsonUnmarshaler = &HTTPDate{}
+	_ bsonMarshaler   = Cron("")
+	_ bsonUnmarshaler = (*Cron)(nil)
+	_ bsonMarshaler   = RRule{}
+	_ bsonUnmarshaler = &RRule{}
+	_ bsonMarshaler   = TimeZone("")
+	_ bsonUnmarshaler = (*TimeZone)(nil)
 	_ bsonMarshaler   = ULID{}
 	_ bsonUnmarshaler = &ULID{}
 	_ bsonMarshaler   = URI("")
@@ -87,6 +101,14 @@ var (
 	_ bsonUnmarshaler = (*RGBColor)(nil)
 	_ bsonMarshaler   = ObjectId{}
 	_ bsonUnmarshaler = &ObjectId{}
+	_ bsonMarshaler   = Interval{}
+	_ bsonUnmarshaler = &Interval{}
+	_ bsonMarshaler   = RepeatingInterval{}
+	_ bsonUnmarshaler = &RepeatingInterval{}
+	_ bsonMarshaler   = YearMonth{}
+	_ bsonUnmarshaler = &YearMonth{}
+	_ bsonMarshaler   = Year(0)
+	_ bsonUnmarshaler = (*Year)(nil)
 
 	_ bsonValueMarshaler   = DateTime{}
 	_ bsonValueUnmarshaler = &DateTime{}
@@ -110,17 +132,19 @@ func (d *Date) UnmarshalBSON(data []byte) error {
 		return err
 	}
 
-	s, ok := v.(string)
-	if !ok {
+	switch s := v.(type) {
+	case string:
+		return d.UnmarshalText([]byte(s))
+	case time.Time:
+		// a BSON datetime, in UTC
+		*d = dateOf(s, DefaultTimeLocation)
+		return nil
+	case nil:
+		*d = Date{}
+		return nil
+	default:
 		return fmt.Errorf("couldn't unmarshal bson bytes value as Date: %w", ErrFormat)
 	}
-
-	rd, err := time.ParseInLocation(RFC3339FullDate, s, DefaultTimeLocation)
-	if err != nil {
-		return err
-	}
-	*d = Date(rd)
-	return nil
 }
 
 // MarshalBSON document from this value.
@@ -130,14 +154,13 @@ func (b Base64) MarshalBSON() ([]byte, error) {
 
 // UnmarshalBSON document into this value.
 func (b *Base64) UnmarshalBSON(data []byte) error {
-	v, err := bsonlite.C.UnmarshalDoc(data)
+	s, err := unmarshalBSONString(data, "base64")
 	if err != nil {
 		return err
 	}
-
-	s, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("couldn't unmarshal bson bytes as base64: %w", ErrFormat)
+	if s == "" {
+		*b = nil
+		return nil
 	}
 
 	vb, err := base64.StdEncoding.DecodeString(s)
@@ -153,22 +176,12 @@ func (d Duration) MarshalBSON() ([]byte, error) {
 }
 
 func (d *Duration) UnmarshalBSON(data []byte) error {
-	v, err := bsonlite.C.UnmarshalDoc(data)
+	s, err := unmarshalBSONString(data, "Duration")
 	if err != nil {
 		return err
 	}
 
-	s, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("couldn't unmarshal bson bytes value as Duration: %w", ErrFormat)
-	}
-
-	rd, err := ParseDuration(s)
-	if err != nil {
-		return err
-	}
-	*d = Duration(rd)
-	return nil
+	return d.UnmarshalText([]byte(s))
 }
 
 // MarshalBSON renders the [DateTime] as a BSON document.
@@ -184,14 +197,97 @@ func (t *DateTime) UnmarshalBSON(data []byte) error {
 		return err
 	}
 
-	tv, ok := v.(time.Time)
-	if !ok {
+	switch tv := v.(type) {
+	case time.Time:
+		*t = DateTime(tv)
+		return nil
+	case nil:
+		*t = DateTime{}
+		return nil
+	default:
 		return fmt.Errorf("couldn't unmarshal bson bytes value as DateTime: %w", ErrFormat)
 	}
-	*t = DateTime(tv)
+}
+
+// MarshalBSON renders the [ExactDateTime] as a BSON document.
+//
+// The date-time is stored as a string, so its representation is preserved.
+func (t ExactDateTime) MarshalBSON() ([]byte, error) {
+	return bsonlite.C.MarshalDoc(t.String())
+}
+
+// UnmarshalBSON reads the [ExactDateTime] from a BSON document.
+func (t *ExactDateTime) UnmarshalBSON(data []byte) error {
+	s, err := unmarshalBSONString(data, "ExactDateTime")
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(s))
+}
+
+// MarshalBSON renders the [ZonedDateTime] as a BSON document.
+//
+// The date-time is stored as a string, so its annotations are preserved.
+func (z ZonedDateTime) MarshalBSON() ([]byte, error) {
+	return bsonlite.C.MarshalDoc(z.String())
+}
+
+// UnmarshalBSON reads the [ZonedDateTime] from a BSON document.
+func (z *ZonedDateTime) UnmarshalBSON(data []byte) error {
+	s, err := unmarshalBSONString(data, "ZonedDateTime")
+	if err != nil {
+		return err
+	}
+	return z.UnmarshalText([]byte(s))
+}
+
+// MarshalBSON renders the [HTTPDate] as a BSON document.
+//
+// The HTTP-date is stored as a BSON datetime.
+func (d HTTPDate) MarshalBSON() ([]byte, error) {
+	return DateTime(d).MarshalBSON()
+}
+
+// UnmarshalBSON reads the [HTTPDate] from a BSON document.
+func (d *HTTPDate) UnmarshalBSON(data []byte) error {
+	var t DateTime
+	if err := t.UnmarshalBSON(data); err != nil {
+		return err
+	}
+	*d = HTTPDateOf(t)
 	return nil
 }
 
+// MarshalBSON renders the [CivilDate] as a BSON document.
+//
+// The date is stored as a string, like [Date].
+func (d CivilDate) MarshalBSON() ([]byte, error) {
+	return bsonlite.C.MarshalDoc(d.String())
+}
+
+// UnmarshalBSON reads the [CivilDate] from a BSON document.
+//
+// Both strings and BSON datetimes are accepted. A BSON datetime yields its calendar day in UTC.
+func (d *CivilDate) UnmarshalBSON(data []byte) error {
+	v, err := bsonlite.C.UnmarshalDoc(data)
+	if err != nil {
+		return err
+	}
+
+	switch s := v.(type) {
+	case string:
+		return d.UnmarshalText([]byte(s))
+	case time.Time:
+		*d = civilDateOf(s)
+		return nil
+	case nil:
+		*d = CivilDate{}
+		return nil
+	default:
+		return fmt.Errorf("couldn't unmarshal bson bytes value as CivilDate: %w", ErrFormat)
+	}
+}
+
 // MarshalBSONValue marshals a [DateTime] as a BSON DateTime value (type 0x09),
 // an int64 representing milliseconds since epoch.
 //
@@ -235,14 +331,13 @@ func (u ULID) MarshalBSON() ([]byte, error) {
 
 // UnmarshalBSON document into this value.
 func (u *ULID) UnmarshalBSON(data []byte) error {
-	v, err := bsonlite.C.UnmarshalDoc(data)
+	s, err := unmarshalBSONString(data, "ULID")
 	if err != nil {
 		return err
 	}
-
-	s, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("couldn't unmarshal bson bytes as ULID: %w", ErrFormat)
+	if s == "" {
+		*u = NewULIDZero()
+		return nil
 	}
 
 	id, err := ulid.ParseStrict(s)
@@ -253,317 +348,326 @@ func (u *ULID) UnmarshalBSON(data []byte) error {
 	return nil
 }
 
+// MarshalBSON document from this value.
+func (s StringFormat[V]) MarshalBSON() ([]byte, error) {
+	return bsonlite.C.MarshalDoc(s.String())
+}
+
+// UnmarshalBSON document into this value.
+func (s *StringFormat[V]) UnmarshalBSON(data []byte) error {
+	var spec V
+	str, err := unmarshalBSONString(data, spec.FormatName())
+	if err != nil {
+		return err
+	}
+	return unmarshaled(s, StringFormat[V](str))
+}
+
 // unmarshalBSONString is a helper for string-based strfmt types.
 func unmarshalBSONString(data []byte, typeName string) (string, error) {
 	v, err := bsonlite.C.UnmarshalDoc(data)
 	if err != nil {
 		return "", err
 	}
-	s, ok := v.(string)
-	if !ok {
+	switch s := v.(type) {
+	case string:
+		return s, nil
+	case nil:
+		// a BSON null yields the zero value
+		return "", nil
+	default:
 		return "", fmt.Errorf("couldn't unmarshal bson bytes as %s: %w", typeName, ErrFormat)
 	}
-	return s, nil
 }
 
 // MarshalBSON document from this value.
 func (u URI) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[uriFormat](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *URI) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "uri")
-	if err != nil {
-		return err
-	}
-	*u = URI(s)
-	return nil
+	return (*StringFormat[uriFormat])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (e Email) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(e.String())
+	return StringFormat[emailFormat](e).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (e *Email) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "email")
-	if err != nil {
-		return err
-	}
-	*e = Email(s)
-	return nil
+	return (*StringFormat[emailFormat])(e).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (h Hostname) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(h.String())
+	return StringFormat[hostnameFormat](h).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (h *Hostname) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "hostname")
+	return (*StringFormat[hostnameFormat])(h).UnmarshalBSON(data)
+}
+
+// MarshalBSON document from this value.
+func (c Cron) MarshalBSON() ([]byte, error) {
+	return StringFormat[cronFormat](c).MarshalBSON()
+}
+
+// UnmarshalBSON document into this value.
+func (c *Cron) UnmarshalBSON(data []byte) error {
+	return (*StringFormat[cronFormat])(c).UnmarshalBSON(data)
+}
+
+// MarshalBSON document from this value.
+func (r RRule) MarshalBSON() ([]byte, error) {
+	return bsonlite.C.MarshalDoc(r.String())
+}
+
+// UnmarshalBSON document into this value.
+func (r *RRule) UnmarshalBSON(data []byte) error {
+	s, err := unmarshalBSONString(data, "rrule")
 	if err != nil {
 		return err
 	}
-	*h = Hostname(s)
-	return nil
+	return r.UnmarshalText([]byte(s))
+}
+
+// MarshalBSON document from this value.
+func (z TimeZone) MarshalBSON() ([]byte, error) {
+	return StringFormat[timeZoneFormat](z).MarshalBSON()
+}
+
+// UnmarshalBSON document into this value.
+func (z *TimeZone) UnmarshalBSON(data []byte) error {
+	return (*StringFormat[timeZoneFormat])(z).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u IPv4) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[ipv4Format](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *IPv4) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "ipv4")
-	if err != nil {
-		return err
-	}
-	*u = IPv4(s)
-	return nil
+	return (*StringFormat[ipv4Format])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u IPv6) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[ipv6Format](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *IPv6) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "ipv6")
-	if err != nil {
-		return err
-	}
-	*u = IPv6(s)
-	return nil
+	return (*StringFormat[ipv6Format])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u CIDR) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[cidrFormat](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *CIDR) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "CIDR")
-	if err != nil {
-		return err
-	}
-	*u = CIDR(s)
-	return nil
+	return (*StringFormat[cidrFormat])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u MAC) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[macFormat](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *MAC) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "MAC")
-	if err != nil {
-		return err
-	}
-	*u = MAC(s)
-	return nil
+	return (*StringFormat[macFormat])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (r Password) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(r.String())
+	return StringFormat[passwordFormat](r).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (r *Password) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "Password")
-	if err != nil {
-		return err
-	}
-	*r = Password(s)
-	return nil
+	return (*StringFormat[passwordFormat])(r).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u UUID) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[uuidFormat](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *UUID) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "UUID")
-	if err != nil {
-		return err
-	}
-	*u = UUID(s)
-	return nil
+	return (*StringFormat[uuidFormat])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u UUID3) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[uuid3Format](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *UUID3) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "UUID3")
-	if err != nil {
-		return err
-	}
-	*u = UUID3(s)
-	return nil
+	return (*StringFormat[uuid3Format])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u UUID4) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[uuid4Format](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *UUID4) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "UUID4")
-	if err != nil {
-		return err
-	}
-	*u = UUID4(s)
-	return nil
+	return (*StringFormat[uuid4Format])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u UUID5) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[uuid5Format](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *UUID5) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "UUID5")
-	if err != nil {
-		return err
-	}
-	*u = UUID5(s)
-	return nil
+	return (*StringFormat[uuid5Format])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u UUID7) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[uuid7Format](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *UUID7) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "UUID7")
-	if err != nil {
-		return err
-	}
-	*u = UUID7(s)
-	return nil
+	return (*StringFormat[uuid7Format])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u ISBN) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[isbnFormat](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *ISBN) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "ISBN")
-	if err != nil {
-		return err
-	}
-	*u = ISBN(s)
-	return nil
+	return (*StringFormat[isbnFormat])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u ISBN10) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[isbn10Format](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *ISBN10) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "ISBN10")
-	if err != nil {
-		return err
-	}
-	*u = ISBN10(s)
-	return nil
+	return (*StringFormat[isbn10Format])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u ISBN13) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[isbn13Format](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *ISBN13) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "ISBN13")
-	if err != nil {
-		return err
-	}
-	*u = ISBN13(s)
-	return nil
+	return (*StringFormat[isbn13Format])(u).UnmarshalBSON(data)
 }
 
 // MarshalBSON document from this value.
 func (u CreditCard) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+	return StringFormat[creditCardFormat](u).MarshalBSON()
 }
 
 // UnmarshalBSON document into this value.
 func (u *CreditCard) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "CreditCard")
+	return (*StringFormat[creditCardFormat])(u).UnmarshalBSON(data)
+}
+
+// MarshalBSON document from this value.
+func (u SSN) MarshalBSON() ([]byte, error) {
+	return StringFormat[ssnFormat](u).MarshalBSON()
+}
+
+// UnmarshalBSON document into this value.
+func (u *SSN) UnmarshalBSON(data []byte) error {
+	return (*StringFormat[ssnFormat])(u).UnmarshalBSON(data)
+}
+
+// MarshalBSON document from this value.
+func (h HexColor) MarshalBSON() ([]byte, error) {
+	return StringFormat[hexColorFormat](h).MarshalBSON()
+}
+
+// UnmarshalBSON document into this value.
+func (h *HexColor) UnmarshalBSON(data []byte) error {
+	return (*StringFormat[hexColorFormat])(h).UnmarshalBSON(data)
+}
+
+// MarshalBSON document from this value.
+func (r RGBColor) MarshalBSON() ([]byte, error) {
+	return StringFormat[rgbColorFormat](r).MarshalBSON()
+}
+
+// UnmarshalBSON document into this value.
+func (r *RGBColor) UnmarshalBSON(data []byte) error {
+	return (*StringFormat[rgbColorFormat])(r).UnmarshalBSON(data)
+}
+
+// MarshalBSON document from this value.
+func (i Interval) MarshalBSON() ([]byte, error) {
+	return bsonlite.C.MarshalDoc(i.String())
+}
+
+// UnmarshalBSON document into this value.
+func (i *Interval) UnmarshalBSON(data []byte) error {
+	s, err := unmarshalBSONString(data, "Interval")
 	if err != nil {
 		return err
 	}
-	*u = CreditCard(s)
-	return nil
+	return i.UnmarshalText([]byte(s))
 }
 
 // MarshalBSON document from this value.
-func (u SSN) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(u.String())
+func (r RepeatingInterval) MarshalBSON() ([]byte, error) {
+	return bsonlite.C.MarshalDoc(r.String())
 }
 
 // UnmarshalBSON document into this value.
-func (u *SSN) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "SSN")
+func (r *RepeatingInterval) UnmarshalBSON(data []byte) error {
+	s, err := unmarshalBSONString(data, "RepeatingInterval")
 	if err != nil {
 		return err
 	}
-	*u = SSN(s)
-	return nil
+	return r.UnmarshalText([]byte(s))
 }
 
 // MarshalBSON document from this value.
-func (h HexColor) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(h.String())
+func (ym YearMonth) MarshalBSON() ([]byte, error) {
+	return bsonlite.C.MarshalDoc(ym.String())
 }
 
 // UnmarshalBSON document into this value.
-func (h *HexColor) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "HexColor")
+func (ym *YearMonth) UnmarshalBSON(data []byte) error {
+	s, err := unmarshalBSONString(data, "YearMonth")
 	if err != nil {
 		return err
 	}
-	*h = HexColor(s)
-	return nil
+	return ym.UnmarshalText([]byte(s))
 }
 
 // MarshalBSON document from this value.
-func (r RGBColor) MarshalBSON() ([]byte, error) {
-	return bsonlite.C.MarshalDoc(r.String())
+func (y Year) MarshalBSON() ([]byte, error) {
+	return bsonlite.C.MarshalDoc(y.String())
 }
 
 // UnmarshalBSON document into this value.
-func (r *RGBColor) UnmarshalBSON(data []byte) error {
-	s, err := unmarshalBSONString(data, "RGBColor")
+func (y *Year) UnmarshalBSON(data []byte) error {
+	s, err := unmarshalBSONString(data, "Year")
 	if err != nil {
 		return err
 	}
-	*r = RGBColor(s)
-	return nil
+	return y.UnmarshalText([]byte(s))
 }
 
 // MarshalBSON renders the object id as a BSON document.
@@ -578,6 +682,10 @@ func (id *ObjectId) UnmarshalBSON(data []byte) error {
 		return err
 	}
 
+	if v == nil {
+		*id = nilObjectID
+		return nil
+	}
 	oid, ok := v.([12]byte)
 	if !ok {
 		return fmt.Errorf("couldn't unmarshal bson bytes as ObjectId: %w", ErrFormat)
@@ -32,6 +32,35 @@ func TestBSONDate(t *testing.T) {
 	assert.EqualT(t, dateOriginal, dateCopy)
 }
 
+func TestBSONCivilDate(t *testing.T) {
+	original := NewCivilDate(2014, time.October, 10)
+
+	bsonData, err := original.MarshalBSON()
+	require.NoError(t, err)
+
+	var dateCopy CivilDate
+	require.NoError(t, dateCopy.UnmarshalBSON(bsonData))
+	assert.EqualT(t, original, dateCopy)
+
+	var date Date
+	require.NoError(t, date.UnmarshalBSON(bsonData))
+	assert.EqualT(t, original, date.Civil())
+}
+
+func TestBSONDate_DateTime(t *testing.T) {
+	// a BSON datetime is normalized to its calendar day in UTC
+	bsonData, err := DateTime(time.Date(2014, 10, 10, 23, 30, 0, 0, time.UTC)).MarshalBSON()
+	require.NoError(t, err)
+
+	var date Date
+	require.NoError(t, date.UnmarshalBSON(bsonData))
+	assert.EqualT(t, Date(time.Date(2014, 10, 10, 0, 0, 0, 0, DefaultTimeLocation)), date)
+
+	var civil CivilDate
+	require.NoError(t, civil.UnmarshalBSON(bsonData))
+	assert.EqualT(t, NewCivilDate(2014, time.October, 10), civil)
+}
+
 func TestBSONBase64(t *testing.T) {
 	const b64 string = "This is a byte array with unprintable chars, but it also isn"
 	b := []byte(b64)
@@ -99,6 +128,56 @@ func TestBSONULID(t *testing.T) {
 	})
 }
 
+func TestBSONInterval(t *testing.T) {
+	interval, err := ParseInterval("2024-01-01/P1M")
+	require.NoError(t, err)
+
+	bsonData, err := interval.MarshalBSON()
+	require.NoError(t, err)
+
+	var intervalCopy Interval
+	err = intervalCopy.UnmarshalBSON(bsonData)
+	require.NoError(t, err)
+	assert.EqualT(t, interval.String(), intervalCopy.String())
+
+	repeating, err := ParseRepeatingInterval("R3/2024-01-01/P1M")
+	require.NoError(t, err)
+
+	bsonData, err = repeating.MarshalBSON()
+	require.NoError(t, err)
+
+	var repeatingCopy RepeatingInterval
+	err = repeatingCopy.UnmarshalBSON(bsonData)
+	require.NoError(t, err)
+	assert.EqualT(t, repeating.String(), repeatingCopy.String())
+
+	err = repeatingCopy.UnmarshalBSON([]byte("yada"))
+	require.Error(t, err)
+}
+
+func TestBSONYearMonth(t *testing.T) {
+	ym := NewYearMonth(2024, time.May)
+	bsonData, err := ym.MarshalBSON()
+	require.NoError(t, err)
+
+	var ymCopy YearMonth
+	err = ymCopy.UnmarshalBSON(bsonData)
+	require.NoError(t, err)
+	assert.EqualT(t, ym, ymCopy)
+
+	y := Year(2024)
+	bsonData, err = y.MarshalBSON()
+	require.NoError(t, err)
+
+	var yCopy Year
+	err = yCopy.UnmarshalBSON(bsonData)
+	require.NoError(t, err)
+	assert.EqualT(t, y, yCopy)
+
+	err = ymCopy.UnmarshalBSON(bsonData)
+	require.Error(t, err)
+}
+
 func TestFormatBSON(t *testing.T) {
 	t.Run("with URI", func(t *testing.T) {
 		t.Run("should bson.Marshal and bson.Unmarshal", func(t *testing.T) {
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt/internal/bsonlite"
+)
+
+// Nullable wraps a format to tell apart an absent value, a null value and the zero value of the format.
+//
+// A [Nullable] is in one of three states:
+//
+//   - absent: the zero [Nullable], i.e. the value was not found in the input
+//   - null: Present is true and Valid is false, e.g. after a JSON null, a SQL NULL or a BSON null
+//   - set: Present and Valid are true and V holds the value, which may be the zero value of the format
+//
+// An absent [Nullable] is zero, so it is left out by the "omitzero" JSON tag option. A null one renders as null.
+//
+// The wrapped type is expected to be one of the formats of this package, or any type supporting
+// the same encodings.
+type Nullable[T any] struct {
+	// V is the value, when Valid is true.
+	V T
+
+	// Valid is true when V holds a value, i.e. the value is not null.
+	Valid bool
+
+	// Present is true when the value is null or set.
+	Present bool
+}
+
+// NullableOf returns a [Nullable] set to v.
+func NullableOf[T any](v T) Nullable[T] {
+	return Nullable[T]{V: v, Valid: true, Present: true}
+}
+
+// Null returns a null [Nullable].
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{Present: true}
+}
+
+// Get returns the value and whether it is set.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.V, n.Valid
+}
+
+// IsNull returns true when the value is present and null.
+func (n Nullable[T]) IsNull() bool {
+	return n.Present && !n.Valid
+}
+
+// IsZero returns true when the value is absent.
+func (n Nullable[T]) IsZero() bool {
+	return !n.Present
+}
+
+// MarshalJSON renders the value as JSON, or null.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte(jsonNull), nil
+	}
+
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON sets the value from JSON. A JSON null sets a null value.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		*n = Null[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = NullableOf(v)
+
+	return nil
+}
+
+// Scan reads the value from a database driver. A SQL NULL sets a null value.
+func (n *Nullable[T]) Scan(raw any) error {
+	if raw == nil {
+		*n = Null[T]()
+		return nil
+	}
+
+	var v T
+	scanner, ok := any(&v).(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("cannot sql.Scan() strfmt.Nullable[%T]: %w", v, ErrFormat)
+	}
+	if err := scanner.Scan(raw); err != nil {
+		return err
+	}
+	*n = NullableOf(v)
+
+	return nil
+}
+
+// Value converts the value to a database driver value. A null or absent value yields a SQL NULL.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil //nolint:nilnil // a SQL NULL
+	}
+	if valuer, ok := any(n.V).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+// MarshalBSON renders the value as a BSON document, holding null when the value is not set.
+func (n Nullable[T]) MarshalBSON() ([]byte, error) {
+	if !n.Valid {
+		return bsonlite.C.MarshalDoc(nil)
+	}
+	marshaler, ok := any(n.V).(bsonMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("cannot marshal strfmt.Nullable[%T] as bson: %w", n.V, ErrFormat)
+	}
+
+	return marshaler.MarshalBSON()
+}
+
+// UnmarshalBSON reads the value from a BSON document. A BSON null sets a null value.
+func (n *Nullable[T]) UnmarshalBSON(data []byte) error {
+	v, err := bsonlite.C.UnmarshalDoc(data)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		*n = Null[T]()
+		return nil
+	}
+
+	var value T
+	unmarshaler, ok := any(&value).(bsonUnmarshaler)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal bson bytes as strfmt.Nullable[%T]: %w", value, ErrFormat)
+	}
+	if err := unmarshaler.UnmarshalBSON(data); err != nil {
+		return err
+	}
+	*n = NullableOf(value)
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+var (
+	_ sql.Scanner    = &Nullable[Email]{}
+	_ driver.Valuer  = Nullable[Email]{}
+	_ json.Marshaler = Nullable[Email]{}
+	_ bsonMarshaler  = Nullable[Email]{}
+)
+
+func TestNullable_JSON(t *testing.T) {
+	type patch struct {
+		Email Nullable[Email]    `json:"email,omitzero"`
+		Date  Nullable[Date]     `json:"date,omitzero"`
+		TTL   Nullable[Duration] `json:"ttl,omitzero"`
+	}
+
+	t.Run("should tell absent, null and zero apart", func(t *testing.T) {
+		var p patch
+		require.NoError(t, json.Unmarshal([]byte(`{"email":null,"ttl":"0s"}`), &p))
+
+		assert.TrueT(t, p.Email.IsNull())
+		assert.FalseT(t, p.Email.IsZero())
+
+		assert.TrueT(t, p.Date.IsZero())
+		assert.FalseT(t, p.Date.IsNull())
+
+		ttl, ok := p.TTL.Get()
+		assert.TrueT(t, ok)
+		assert.TrueT(t, ttl.IsZero())
+	})
+
+	t.Run("should render absent values as omitted and null values as null", func(t *testing.T) {
+		js, err := json.Marshal(patch{
+			Email: Null[Email](),
+			TTL:   NullableOf(Duration(time.Minute)),
+		})
+		require.NoError(t, err)
+		assert.JSONEqT(t, `{"email":null,"ttl":"1m0s"}`, string(js))
+	})
+
+	t.Run("should unmarshal the wrapped format", func(t *testing.T) {
+		withValidateOnUnmarshal(t)
+
+		var p patch
+		require.ErrorIs(t, json.Unmarshal([]byte(`{"email":"john"}`), &p), ErrFormat)
+		require.NoError(t, json.Unmarshal([]byte(`{"date":"2024-05-01"}`), &p))
+		assert.EqualT(t, "2024-05-01", p.Date.V.String())
+	})
+}
+
+func TestNullable_SQL(t *testing.T) {
+	var n Nullable[UUID]
+	v, err := n.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	require.NoError(t, n.Scan(nil))
+	assert.TrueT(t, n.IsNull())
+
+	require.NoError(t, n.Scan([]byte("f47ac10b-58cc-4372-a567-0e02b2c3d479")))
+	id, ok := n.Get()
+	require.TrueT(t, ok)
+	assert.EqualT(t, UUID("f47ac10b-58cc-4372-a567-0e02b2c3d479"), id)
+
+	v, err = n.Value()
+	require.NoError(t, err)
+	assert.EqualValues(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479", v)
+
+	v, err = NullableOf(42).Value()
+	require.NoError(t, err)
+	assert.EqualValues(t, int64(42), v)
+
+	var unsupported Nullable[int]
+	require.ErrorIs(t, unsupported.Scan(int64(1)), ErrFormat)
+}
+
+func TestNullable_BSON(t *testing.T) {
+	for _, n := range []Nullable[ObjectId]{
+		NullableOf(NewObjectId("507f1f77bcf86cd799439011")),
+		NullableOf(ObjectId{}),
+		Null[ObjectId](),
+	} {
+		doc, err := n.MarshalBSON()
+		require.NoError(t, err)
+
+		var back Nullable[ObjectId]
+		require.NoError(t, back.UnmarshalBSON(doc))
+		assert.EqualT(t, n, back)
+	}
+
+	_, err := NullableOf(42).MarshalBSON()
+	require.ErrorIs(t, err, ErrFormat)
+}
@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	periodDesignator = 'P'
+	timeDesignator   = 'T'
+)
+
+// period is the nominal duration expressed by an ISO 8601 duration designator, e.g. "P1Y2M10DT2H30M".
+//
+// Calendar components (years, months, weeks, days) are kept apart from the clock component,
+// as their actual length depends on the instant they are applied to.
+type period struct {
+	years  int
+	months int
+	weeks  int
+	days   int
+	clock  time.Duration
+}
+
+// isPeriod tells if the string looks like an ISO 8601 duration.
+func isPeriod(s string) bool {
+	return len(s) > 0 && s[0] == periodDesignator
+}
+
+// parsePeriod parses an ISO 8601 duration such as "P1Y2M10DT2H30M", "P2W" or "PT0.5S".
+//
+// Only the lowest order component of the time part may have a decimal fraction.
+// Calendar components must be integers.
+//
+//nolint:gocognit,gocyclo,cyclop // hand-written lexer, a single pass over the input
+func parsePeriod(s string) (period, error) {
+	orig := s
+	var p period
+
+	if !isPeriod(s) {
+		return p, parsePeriodError(orig, "expected a leading duration designator 'P'")
+	}
+
+	datePart, timePart, hasTime := strings.Cut(s[1:], string(timeDesignator))
+	if datePart == "" && !hasTime {
+		return p, parsePeriodError(orig, "empty duration")
+	}
+	if hasTime && timePart == "" {
+		return p, parsePeriodError(orig, "expected a time component after 'T'")
+	}
+
+	const dateDesignators = "YMWD"
+	pos := 0
+	for datePart != "" {
+		v, rem, ok := leadingInt(datePart)
+		if !ok || v > math.MaxInt32 {
+			return period{}, parsePeriodError(orig, "numerical overflow")
+		}
+		if len(rem) == len(datePart) {
+			return period{}, parsePeriodError(orig, fmt.Sprintf("expected a numerical value, but got %q", datePart[0]))
+		}
+		if rem == "" {
+			return period{}, parsePeriodError(orig, "missing designator in duration")
+		}
+		if rem[0] == '.' || rem[0] == ',' {
+			return period{}, parsePeriodError(orig, "fractional calendar components are not supported")
+		}
+
+		idx := strings.IndexByte(dateDesignators[pos:], rem[0])
+		if idx < 0 {
+			return period{}, parsePeriodError(orig, fmt.Sprintf("unexpected designator %q in date part", rem[0]))
+		}
+		pos += idx + 1
+
+		n := int(v)
+		switch rem[0] {
+		case 'Y':
+			p.years = n
+		case 'M':
+			p.months = n
+		case 'W':
+			p.weeks = n
+		default:
+			p.days = n
+		}
+		datePart = rem[1:]
+	}
+
+	const timeDesignators = "HMS"
+	pos = 0
+	var clock uint64
+	for timePart != "" {
+		v, rem, ok := leadingInt(timePart)
+		if !ok {
+			return period{}, parsePeriodError(orig, "numerical overflow")
+		}
+		if len(rem) == len(timePart) {
+			return period{}, parsePeriodError(orig, fmt.Sprintf("expected a numerical value, but got %q", timePart[0]))
+		}
+
+		var (
+			f        uint64
+			scale    float64 = 1
+			fraction bool
+		)
+		if rem != "" && (rem[0] == '.' || rem[0] == ',') {
+			pl := len(rem) - 1
+			f, scale, rem = leadingFraction(rem[1:])
+			if pl == len(rem) {
+				return period{}, parsePeriodError(orig, "expected digits after the decimal sign")
+			}
+			fraction = true
+		}
+
+		if rem == "" {
+			return period{}, parsePeriodError(orig, "missing designator in duration")
+		}
+
+		idx := strings.IndexByte(timeDesignators[pos:], rem[0])
+		if idx < 0 {
+			return period{}, parsePeriodError(orig, fmt.Sprintf("unexpected designator %q in time part", rem[0]))
+		}
+		pos += idx + 1
+		timePart = rem[1:]
+
+		if fraction && timePart != "" {
+			return period{}, parsePeriodError(orig, "only the last component may have a decimal fraction")
+		}
+
+		var unit uint64
+		switch rem[0] {
+		case 'H':
+			unit = hours
+		case 'M':
+			unit = minutes
+		default:
+			unit = seconds
+		}
+
+		if v > maxUint64/unit {
+			return period{}, parsePeriodError(orig, "numerical overflow")
+		}
+		v *= unit
+		if f > 0 {
+			v += uint64(float64(f) * (float64(unit) / scale))
+		}
+
+		clock += v
+		if clock > maxUint64-1 {
+			return period{}, parsePeriodError(orig, "numerical overflow")
+		}
+	}
+	p.clock = time.Duration(clock) //nolint:gosec // overflow has been checked above
+
+	return p, nil
+}
+
+func parsePeriodError(s, msg string) error {
+	return fmt.Errorf("invalid ISO 8601 duration: %s: %s: %w", s, msg, ErrFormat)
+}
+
+// addTo returns t shifted by n times the period.
+//
+// It reports false when the shift overflows.
+func (p period) addTo(t time.Time, n int) (time.Time, bool) {
+	weekDays, okWeeks := mulInt64(int64(p.weeks), daysInWeek)
+	periodDays, okPeriod := addInt64(weekDays, int64(p.days))
+	years, okYears := mulInt64(int64(n), int64(p.years))
+	months, okMonths := mulInt64(int64(n), int64(p.months))
+	days, okDays := mulInt64(int64(n), periodDays)
+	if !okWeeks || !okPeriod || !okYears || !okMonths || !okDays ||
+		!fitsInt(years) || !fitsInt(months) || !fitsInt(days) {
+		return time.Time{}, false
+	}
+
+	return addDurationTimes(t.AddDate(int(years), int(months), int(days)), p.clock, n)
+}
+
+// addDurationTimes returns t shifted by n times d.
+//
+// Unlike [time.Time.Add], the shift may exceed the range of a [time.Duration]: it reports false only
+// when the resulting instant overflows.
+func addDurationTimes(t time.Time, d time.Duration, n int) (time.Time, bool) {
+	if shift, ok := mulInt64(int64(n), int64(d)); ok {
+		return t.Add(time.Duration(shift)), true
+	}
+
+	// split the shift into seconds and nanoseconds: with n = high*1e9 + low and d = whole*1e9 + frac (in ns),
+	// n*d = (n*whole + high*frac + low*frac/1e9)*1e9 + low*frac%1e9, where low*frac fits in an int64
+	const second = int64(time.Second)
+	high, low := int64(n)/second, int64(n)%second
+	whole, frac := int64(d)/second, int64(d)%second
+	wholeSeconds, okWhole := mulInt64(int64(n), whole)
+	fracSeconds, okFrac := mulInt64(high, frac)
+	seconds, okSum := addInt64(wholeSeconds, fracSeconds)
+	seconds, okCarry := addInt64(seconds, low*frac/second)
+	unix, okUnix := addInt64(t.Unix(), seconds)
+	if !okWhole || !okFrac || !okSum || !okCarry || !okUnix || unix > maxUnixSeconds || unix < -maxUnixSeconds {
+		return time.Time{}, false
+	}
+
+	return time.Unix(unix, int64(t.Nanosecond())).In(t.Location()).Add(time.Duration(low * frac % second)), true
+}
+
+// maxUnixSeconds bounds the instants reached by addDurationTimes, well within the range of [time.Time].
+const maxUnixSeconds = 1 << 62
+
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+
+	return c, true
+}
+
+func addInt64(a, b int64) (int64, bool) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, false
+	}
+
+	return c, true
+}
+
+func fitsInt(n int64) bool {
+	return n >= math.MinInt && n <= math.MaxInt
+}
+
+// String renders the period in its ISO 8601 form.
+func (p period) String() string {
+	if p == (period{}) {
+		return "PT0S"
+	}
+
+	var w strings.Builder
+	w.WriteByte(periodDesignator)
+	writePeriodComponent(&w, int64(p.years), 'Y')
+	writePeriodComponent(&w, int64(p.months), 'M')
+	writePeriodComponent(&w, int64(p.weeks), 'W')
+	writePeriodComponent(&w, int64(p.days), 'D')
+
+	if p.clock == 0 {
+		return w.String()
+	}
+
+	w.WriteByte(timeDesignator)
+	rem := p.clock
+	h := rem / time.Hour
+	rem -= h * time.Hour
+	m := rem / time.Minute
+	rem -= m * time.Minute
+	writePeriodComponent(&w, int64(h), 'H')
+	writePeriodComponent(&w, int64(m), 'M')
+
+	if rem == 0 {
+		return w.String()
+	}
+
+	s := rem / time.Second
+	w.WriteString(strconv.FormatInt(int64(s), 10))
+	if frac := rem - s*time.Second; frac != 0 {
+		w.WriteByte('.')
+		w.WriteString(strings.TrimRight(fmt.Sprintf("%09d", int64(frac)), "0"))
+	}
+	w.WriteByte('S')
+
+	return w.String()
+}
+
+func writePeriodComponent(w *strings.Builder, v int64, designator byte) {
+	if v == 0 {
+		return
+	}
+
+	w.WriteString(strconv.FormatInt(v, 10))
+	w.WriteByte(designator)
+}
@@ -0,0 +1,375 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// rruleHorizonYears bounds the expansion of recurrence rules which are bounded by neither COUNT nor UNTIL,
+// or which stop matching.
+const rruleHorizonYears = 400
+
+// All iterates over the occurrences of the recurrence rule, from start (DTSTART) onwards.
+//
+// Occurrences are computed on the wall clock, in the location of start. The time of day defaults
+// to the time of start, and the day to the day of start, as prescribed by RFC 5545.
+// Like other implementations, start itself is only an occurrence when it matches the rule.
+//
+// The iteration stops after COUNT occurrences, or past UNTIL. Occurrences which are equal to any of the
+// exdates (EXDATE) are skipped: they still count as occurrences for COUNT.
+//
+// The iteration of a rule bounded by neither COUNT nor UNTIL stops after 400 years: callers should
+// break out of the loop.
+func (r RRule) All(start DateTime, exdates ...DateTime) iter.Seq[DateTime] {
+	return func(yield func(DateTime) bool) {
+		if r.IsZero() {
+			return
+		}
+
+		loc := time.Time(start).Location()
+		first := wallClock(time.Time(start)).Truncate(time.Second)
+		horizon := first.AddDate(rruleHorizonYears, 0, 0)
+		e := newRRuleExpansion(r, first)
+
+		count := 0
+		for p := e.firstPeriod(first); !p.After(horizon); {
+			var candidates []time.Time
+			candidates, p = e.period(p)
+
+			for _, c := range candidates {
+				if c.Before(first) {
+					continue
+				}
+				occurrence := wallClockIn(c, loc)
+				if r.isBeyondUntil(c, occurrence) {
+					return
+				}
+				count++
+				if !slices.ContainsFunc(exdates, func(ex DateTime) bool { return occurrence.Equal(time.Time(ex)) }) {
+					if !yield(DateTime(occurrence)) {
+						return
+					}
+				}
+				if r.count > 0 && count >= r.count {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Occurrences returns at most n occurrences of the recurrence rule, from start (DTSTART) onwards.
+//
+// See [RRule.All].
+func (r RRule) Occurrences(start DateTime, n int, exdates ...DateTime) []DateTime {
+	if n <= 0 {
+		return nil
+	}
+	occurrences := make([]DateTime, 0, n)
+
+	for occurrence := range r.All(start, exdates...) {
+		occurrences = append(occurrences, occurrence)
+		if len(occurrences) == n {
+			break
+		}
+	}
+
+	return occurrences
+}
+
+// isBeyondUntil tells if an occurrence (given as a wall clock and as an instant) lies past UNTIL.
+func (r RRule) isBeyondUntil(wall, instant time.Time) bool {
+	switch r.untilForm {
+	case rruleUntilUTC:
+		return instant.After(r.until)
+	case rruleUntilLocal:
+		return wall.After(r.until)
+	case rruleUntilDate:
+		return !wall.Before(r.until.AddDate(0, 0, 1))
+	default:
+		return false
+	}
+}
+
+// wallClock returns the wall clock of t, as a time in UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// wallClockIn returns the time with the wall clock of t in the given location.
+func wallClockIn(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// rruleExpansion is a recurrence rule completed with the defaults derived from its start.
+//
+// All times handled during the expansion are wall clocks, in UTC.
+type rruleExpansion struct {
+	RRule
+}
+
+func newRRuleExpansion(r RRule, start time.Time) rruleExpansion {
+	e := rruleExpansion{RRule: r}
+
+	if len(r.byWeekNo) == 0 && len(r.byYearDay) == 0 && len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		switch r.freq {
+		case RRuleYearly:
+			if len(r.byMonth) == 0 {
+				e.byMonth = []int{int(start.Month())}
+			}
+			e.byMonthDay = []int{start.Day()}
+		case RRuleMonthly:
+			e.byMonthDay = []int{start.Day()}
+		case RRuleWeekly:
+			e.byDay = []RRuleWeekday{{Weekday: start.Weekday()}}
+		default:
+		}
+	}
+
+	if len(r.byHour) == 0 && r.freq > RRuleHourly {
+		e.byHour = []int{start.Hour()}
+	}
+	if len(r.byMinute) == 0 && r.freq > RRuleMinutely {
+		e.byMinute = []int{start.Minute()}
+	}
+	if len(r.bySecond) == 0 && r.freq > RRuleSecondly {
+		e.bySecond = []int{start.Second()}
+	}
+
+	return e
+}
+
+// firstPeriod returns the start of the period which contains t.
+func (e rruleExpansion) firstPeriod(t time.Time) time.Time {
+	switch e.freq {
+	case RRuleYearly:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	case RRuleMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case RRuleWeekly:
+		return e.weekOf(t.Truncate(hoursPerDay * time.Hour))
+	case RRuleDaily:
+		return t.Truncate(hoursPerDay * time.Hour)
+	case RRuleHourly:
+		return t.Truncate(time.Hour)
+	case RRuleMinutely:
+		return t.Truncate(time.Minute)
+	default:
+		return t
+	}
+}
+
+// period returns the sorted candidate occurrences within the period starting at p, and the start of the next period.
+func (e rruleExpansion) period(p time.Time) ([]time.Time, time.Time) {
+	interval := e.Interval()
+
+	var days []time.Time
+	var next time.Time
+	switch e.freq {
+	case RRuleYearly:
+		next = p.AddDate(interval, 0, 0)
+		days = daysBetween(p, p.AddDate(1, 0, 0))
+	case RRuleMonthly:
+		next = p.AddDate(0, interval, 0)
+		days = daysBetween(p, p.AddDate(0, 1, 0))
+	case RRuleWeekly:
+		next = p.AddDate(0, 0, daysInWeek*interval)
+		days = daysBetween(p, p.AddDate(0, 0, daysInWeek))
+	case RRuleDaily:
+		next = p.AddDate(0, 0, interval)
+		days = []time.Time{p}
+	default:
+		var skip bool
+		if next, skip = e.subDailyNext(p); skip {
+			return nil, next
+		}
+		days = []time.Time{p.Truncate(hoursPerDay * time.Hour)}
+	}
+
+	hours, minutes, seconds := e.byHour, e.byMinute, e.bySecond
+	if e.freq <= RRuleHourly {
+		hours = []int{p.Hour()}
+	}
+	if e.freq <= RRuleMinutely {
+		minutes = []int{p.Minute()}
+	}
+	if e.freq == RRuleSecondly {
+		seconds = []int{p.Second()}
+	}
+
+	var candidates []time.Time
+	for _, day := range days {
+		if !e.matchDay(day) {
+			continue
+		}
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				for _, second := range seconds {
+					if second == rruleMaxSecond {
+						// leap seconds cannot be represented
+						continue
+					}
+					candidates = append(candidates, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, time.UTC))
+				}
+			}
+		}
+	}
+
+	if len(e.bySetPos) > 0 {
+		candidates = selectSetPos(candidates, e.bySetPos)
+	}
+
+	return candidates, next
+}
+
+// subDailyNext returns the start of the next period for the sub-daily frequencies.
+//
+// It also tells if the period at p should be skipped, in which case the next period is the first one
+// which may match, past the day, hour or minute which does not.
+func (e rruleExpansion) subDailyNext(p time.Time) (time.Time, bool) {
+	var unit time.Duration
+	switch e.freq {
+	case RRuleHourly:
+		unit = time.Hour
+	case RRuleMinutely:
+		unit = time.Minute
+	default:
+		unit = time.Second
+	}
+	step := time.Duration(e.Interval()) * unit
+
+	day := p.Truncate(hoursPerDay * time.Hour)
+	switch {
+	case !e.matchDay(day):
+		return advance(p, day.AddDate(0, 0, 1), step), true
+	case len(e.byHour) > 0 && !slices.Contains(e.byHour, p.Hour()):
+		return advance(p, p.Truncate(time.Hour).Add(time.Hour), step), true
+	case e.freq <= RRuleMinutely && len(e.byMinute) > 0 && !slices.Contains(e.byMinute, p.Minute()):
+		return advance(p, p.Truncate(time.Minute).Add(time.Minute), step), true
+	case e.freq == RRuleSecondly && len(e.bySecond) > 0 && !slices.Contains(e.bySecond, p.Second()):
+		return p.Add(step), true
+	default:
+		return p.Add(step), false
+	}
+}
+
+// advance returns the first time p + k*step which is not before target, with k > 0.
+func advance(p, target time.Time, step time.Duration) time.Time {
+	k := max((target.Sub(p)+step-1)/step, 1)
+
+	return p.Add(k * step)
+}
+
+// daysBetween returns the days from start (included) to end (excluded).
+func daysBetween(start, end time.Time) []time.Time {
+	var days []time.Time
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+
+	return days
+}
+
+// matchDay tells if the day matches the BYMONTH, BYWEEKNO, BYYEARDAY, BYMONTHDAY and BYDAY rule parts.
+func (e rruleExpansion) matchDay(d time.Time) bool {
+	if len(e.byMonth) > 0 && !slices.Contains(e.byMonth, int(d.Month())) {
+		return false
+	}
+
+	if len(e.byWeekNo) > 0 {
+		week, weeks := e.weekNumber(d)
+		if !slices.Contains(e.byWeekNo, week) && !slices.Contains(e.byWeekNo, week-weeks-1) {
+			return false
+		}
+	}
+
+	if len(e.byYearDay) > 0 {
+		yearDay, days := d.YearDay(), Year(d.Year()).Days()
+		if !slices.Contains(e.byYearDay, yearDay) && !slices.Contains(e.byYearDay, yearDay-days-1) {
+			return false
+		}
+	}
+
+	if len(e.byMonthDay) > 0 {
+		monthDay, days := d.Day(), daysIn(d.Month(), d.Year())
+		if !slices.Contains(e.byMonthDay, monthDay) && !slices.Contains(e.byMonthDay, monthDay-days-1) {
+			return false
+		}
+	}
+
+	return len(e.byDay) == 0 || e.matchWeekday(d)
+}
+
+// matchWeekday tells if the day matches the BYDAY rule part.
+//
+// Ordinals count within the month for FREQ=MONTHLY, or FREQ=YEARLY with BYMONTH, and within the year otherwise.
+func (e rruleExpansion) matchWeekday(d time.Time) bool {
+	withinMonth := e.freq == RRuleMonthly || len(e.RRule.byMonth) > 0
+
+	var day, days int
+	if withinMonth {
+		day, days = d.Day(), daysIn(d.Month(), d.Year())
+	} else {
+		day, days = d.YearDay(), Year(d.Year()).Days()
+	}
+	nth, nthLast := (day-1)/daysInWeek+1, -((days-day)/daysInWeek + 1)
+
+	for _, bd := range e.byDay {
+		if bd.Weekday != d.Weekday() {
+			continue
+		}
+		if bd.Ordinal == 0 || bd.Ordinal == nth || bd.Ordinal == nthLast {
+			return true
+		}
+	}
+
+	return false
+}
+
+// weekOf returns the first day of the week which contains d, according to WKST.
+func (e rruleExpansion) weekOf(d time.Time) time.Time {
+	offset := (int(d.Weekday()) - int(e.weekStart) + daysInWeek) % daysInWeek
+
+	return d.AddDate(0, 0, -offset)
+}
+
+// weekNumber returns the week number of d, and the number of weeks in its week-numbering year, according to WKST.
+//
+// Week 1 is the first week with at least 4 days in the year.
+func (e rruleExpansion) weekNumber(d time.Time) (int, int) {
+	const (
+		daysToMidWeek = 3
+		jan4          = 4
+	)
+
+	weekStart := e.weekOf(d)
+	year := weekStart.AddDate(0, 0, daysToMidWeek).Year()
+	first := e.weekOf(time.Date(year, time.January, jan4, 0, 0, 0, 0, time.UTC))
+	firstOfNext := e.weekOf(time.Date(year+1, time.January, jan4, 0, 0, 0, 0, time.UTC))
+
+	week := int(weekStart.Sub(first)/(hoursPerDay*time.Hour))/daysInWeek + 1
+	weeks := int(firstOfNext.Sub(first)/(hoursPerDay*time.Hour)) / daysInWeek
+
+	return week, weeks
+}
+
+// selectSetPos applies the BYSETPOS rule part to the sorted candidates of a period.
+func selectSetPos(candidates []time.Time, positions []int) []time.Time {
+	selected := make([]time.Time, 0, len(positions))
+	for _, pos := range positions {
+		i := pos - 1
+		if pos < 0 {
+			i = len(candidates) + pos
+		}
+		if i >= 0 && i < len(candidates) {
+			selected = append(selected, candidates[i])
+		}
+	}
+	slices.SortFunc(selected, time.Time.Compare)
+
+	return slices.CompactFunc(selected, time.Time.Equal)
+}
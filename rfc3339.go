@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	leapSecond        = 60
+	lastHourOfDay     = 23
+	lastMinuteOfHour  = 59
+	secondsPerMinute  = 60
+	minutesPerHour    = 60
+	minutesPerDay     = 24 * minutesPerHour
+	nanosecondsDigits = 9
+)
+
+// IsRFC3339DateTime returns true when the string is a valid date-time, strictly following the grammar of RFC 3339, section 5.6.
+//
+// Unlike [IsDateTime], it rejects any representation not allowed by the RFC, such as a missing offset,
+// a space separator or a leap second which does not fall on the last minute of a day in UTC.
+func IsRFC3339DateTime(str string) bool {
+	_, err := parseRFC3339(str)
+	return err == nil
+}
+
+// ParseRFC3339DateTime parses a date-time, strictly following the grammar of RFC 3339, section 5.6.
+//
+// Unlike [ParseDateTime], it does not use [DateTimeFormats].
+//
+// Leap seconds (e.g. "1998-12-31T23:59:60Z") are accepted when they fall on the last minute of a day in UTC.
+// As a [time.Time] cannot represent a leap second, it is rendered as the last nanosecond of that minute.
+func ParseRFC3339DateTime(data string) (DateTime, error) {
+	t, err := parseRFC3339(data)
+	if err != nil {
+		return DateTime{}, err
+	}
+
+	return DateTime(t), nil
+}
+
+// parseRFC3339 parses the ABNF production "date-time" of RFC 3339:
+//
+//	date-time       = full-date "T" full-time
+//	full-date       = date-fullyear "-" date-month "-" date-mday
+//	full-time       = partial-time time-offset
+//	partial-time    = time-hour ":" time-minute ":" time-second [time-secfrac]
+//	time-secfrac    = "." 1*DIGIT
+//	time-offset     = "Z" / time-numoffset
+//	time-numoffset  = ("+" / "-") time-hour ":" time-minute
+//
+// "T" and "Z" may be lowercase.
+//
+//nolint:gocognit,gocyclo,cyclop // a single pass over the grammar
+func parseRFC3339(data string) (time.Time, error) {
+	const minLength = len("2006-01-02T15:04:05Z")
+
+	if len(data) < minLength {
+		return time.Time{}, rfc3339Error(data, "too short")
+	}
+
+	// full-date
+	year, okYear := atoiDigits(data[0:4])
+	month, okMonth := atoiDigits(data[5:7])
+	day, okDay := atoiDigits(data[8:10])
+	if !okYear || !okMonth || !okDay || data[4] != '-' || data[7] != '-' {
+		return time.Time{}, rfc3339Error(data, "invalid full-date")
+	}
+	if month < int(time.January) || month > int(time.December) || day < 1 || day > daysIn(time.Month(month), year) {
+		return time.Time{}, rfc3339Error(data, "invalid full-date")
+	}
+
+	if data[10] != 'T' && data[10] != 't' {
+		return time.Time{}, rfc3339Error(data, "expected 'T' separator")
+	}
+
+	// partial-time
+	hour, okHour := atoiDigits(data[11:13])
+	minute, okMinute := atoiDigits(data[14:16])
+	second, okSecond := atoiDigits(data[17:19])
+	if !okHour || !okMinute || !okSecond || data[13] != ':' || data[16] != ':' {
+		return time.Time{}, rfc3339Error(data, "invalid partial-time")
+	}
+	if hour > lastHourOfDay || minute > lastMinuteOfHour || second > leapSecond {
+		return time.Time{}, rfc3339Error(data, "invalid partial-time")
+	}
+
+	rest := data[19:]
+	var nsec int
+	if rest[0] == '.' {
+		i := 1
+		for ; i < len(rest) && isASCIIDigit(rest[i]); i++ {
+			if i <= nanosecondsDigits {
+				nsec = nsec*decimalBase + int(rest[i]-'0')
+			}
+		}
+		if i == 1 {
+			return time.Time{}, rfc3339Error(data, "expected digits in time-secfrac")
+		}
+		for j := i; j <= nanosecondsDigits; j++ {
+			nsec *= decimalBase
+		}
+		rest = rest[i:]
+	}
+
+	// time-offset
+	var offset int
+	loc := time.UTC
+	switch {
+	case rest == "Z" || rest == "z":
+	case len(rest) == len("+07:00") && (rest[0] == '+' || rest[0] == '-') && rest[3] == ':':
+		offsetHour, okOffsetHour := atoiDigits(rest[1:3])
+		offsetMinute, okOffsetMinute := atoiDigits(rest[4:6])
+		if !okOffsetHour || !okOffsetMinute || offsetHour > lastHourOfDay || offsetMinute > lastMinuteOfHour {
+			return time.Time{}, rfc3339Error(data, "invalid time-offset")
+		}
+		offset = (offsetHour*minutesPerHour + offsetMinute) * secondsPerMinute
+		if rest[0] == '-' {
+			offset = -offset
+		}
+		loc = time.FixedZone("", offset)
+	default:
+		return time.Time{}, rfc3339Error(data, "invalid time-offset")
+	}
+
+	if second == leapSecond {
+		// a leap second may only be inserted on the last minute of a day in UTC
+		utcMinutes := hour*minutesPerHour + minute - offset/secondsPerMinute
+		utcMinutes = (utcMinutes%minutesPerDay + minutesPerDay) % minutesPerDay
+		if utcMinutes != lastHourOfDay*minutesPerHour+lastMinuteOfHour {
+			return time.Time{}, rfc3339Error(data, "leap second not on the last minute of a day in UTC")
+		}
+		second, nsec = leapSecond-1, int(time.Second-1)
+	}
+
+	return time.Date(year, time.Month(month), day, hour, minute, second, nsec, loc), nil
+}
+
+// daysIn returns the number of days in a month of the Gregorian calendar.
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func rfc3339Error(data, msg string) error {
+	return fmt.Errorf("invalid RFC 3339 date-time %q: %s: %w", data, msg, ErrFormat)
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+func TestIsRFC3339DateTime(t *testing.T) {
+	for _, valid := range []string{
+		"1963-06-19T08:30:06.283185Z",
+		"1963-06-19T08:30:06Z",
+		"1937-01-01T12:00:27.87+00:20",
+		"1990-12-31T15:59:50.123-08:00",
+		"1963-06-19t08:30:06.283185z",
+		"2024-02-29T00:00:00Z",
+		"2024-05-01T10:00:00-00:00",
+		"2024-05-01T10:00:00.1234567890123Z",
+		// leap seconds
+		"1998-12-31T23:59:60Z",
+		"1998-12-31T15:59:60.123-08:00",
+		"1998-12-31T23:59:60+00:00",
+		"1999-01-01T00:59:60+01:00",
+		"1998-12-31T23:29:60-00:30",
+	} {
+		assert.TrueTf(t, IsRFC3339DateTime(valid), "expected %q to be valid", valid)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"1990-02-31T15:59:59.123-08:00",
+		"2023-02-29T00:00:00Z",
+		"1990-12-31T15:59:59-24:00",
+		"1990-12-31T15:59:59-08:60",
+		"1963-06-19T08:30:06.283185",
+		"1963-06-19T08:30:06.Z",
+		"1963-06-19 08:30:06Z",
+		"1963-06-19T08:30:06+0200",
+		"06/19/1963 08:30:06 PST",
+		"2013-350T01:01:01Z",
+		"1963-6-19T08:30:06.283185Z",
+		"1963-06-1T08:30:06.283185Z",
+		"1963-06-1৪T00:00:00Z",
+		"1963-06-11T0৪:00:00Z",
+		"1963-13-19T08:30:06Z",
+		"1963-06-19T24:00:00Z",
+		"1963-06-19T08:60:00Z",
+		"1963-06-19T08:30:06Zjunk",
+		// leap seconds on the wrong minute or hour
+		"1998-12-31T23:58:60Z",
+		"1998-12-31T22:59:60Z",
+		"1998-12-31T23:59:60+01:00",
+		"1998-12-31T23:59:61Z",
+	} {
+		assert.FalseTf(t, IsRFC3339DateTime(invalid), "expected %q to be invalid", invalid)
+	}
+}
+
+func TestParseRFC3339DateTime(t *testing.T) {
+	t.Run("should parse offsets", func(t *testing.T) {
+		dt, err := ParseRFC3339DateTime("1990-12-31T15:59:50.123-08:00")
+		require.NoError(t, err)
+
+		tt := time.Time(dt)
+		assert.TrueT(t, tt.Equal(time.Date(1990, 12, 31, 23, 59, 50, 123000000, time.UTC)))
+		_, offset := tt.Zone()
+		assert.EqualT(t, -8*3600, offset)
+	})
+
+	t.Run("should truncate fractions below the nanosecond", func(t *testing.T) {
+		dt, err := ParseRFC3339DateTime("2024-05-01T10:00:00.1234567891Z")
+		require.NoError(t, err)
+		assert.EqualT(t, 123456789, time.Time(dt).Nanosecond())
+	})
+
+	t.Run("should clamp leap seconds", func(t *testing.T) {
+		dt, err := ParseRFC3339DateTime("1998-12-31T23:59:60.5Z")
+		require.NoError(t, err)
+		assert.TrueT(t, time.Time(dt).Equal(time.Date(1998, 12, 31, 23, 59, 59, 999999999, time.UTC)))
+	})
+
+	t.Run("should report errors", func(t *testing.T) {
+		_, err := ParseRFC3339DateTime("2024-05-01T10:00:00+0200")
+		require.ErrorIs(t, err, ErrFormat)
+		assert.StringContainsT(t, err.Error(), "time-offset")
+	})
+}
+
+func TestStrictFormats(t *testing.T) {
+	strict := NewStrictFormats()
+	lenient := NewFormats()
+
+	for _, input := range []string{"2024-05-01T10:00:00,5Z", "2024-05-01T10:00:00x5Z", "2024-05-01T10:00:00+25:00"} {
+		assert.TrueTf(t, lenient.Validates("date-time", input), "expected %q to be valid in lenient mode", input)
+		assert.FalseTf(t, strict.Validates("date-time", input), "expected %q to be invalid in strict mode", input)
+
+		_, err := strict.Parse("date-time", input)
+		require.ErrorIs(t, err, ErrFormat)
+	}
+
+	assert.TrueT(t, strict.Validates("date-time", "1998-12-31T23:59:60Z"))
+	assert.FalseT(t, lenient.Validates("date-time", "1998-12-31T23:59:60Z"))
+
+	v, err := strict.Parse("date-time", "2024-05-01T10:00:00Z")
+	require.NoError(t, err)
+	dt, ok := v.(*DateTime)
+	require.TrueT(t, ok)
+	assert.TrueT(t, time.Time(*dt).Equal(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)))
+
+	var decoded struct {
+		DT DateTime `json:"dt"`
+	}
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: strict.MapStructureHookFunc(),
+		Result:     &decoded,
+	})
+	require.NoError(t, err)
+	require.Error(t, dec.Decode(map[string]any{"dt": "2024-05-01T10:00:00"}))
+	require.NoError(t, dec.Decode(map[string]any{"dt": "1998-12-31T23:59:60Z"}))
+	assert.EqualT(t, 1998, time.Time(decoded.DT).Year())
+
+	// other formats are unchanged
+	assert.TrueT(t, strict.Validates("date", "2024-05-01"))
+	assert.TrueT(t, strict.Validates("uuid", "a8098c1a-f86e-11da-bd1a-00112444be1e"))
+
+	// the default registry is unchanged
+	assert.TrueT(t, Default.Validates("date-time", "2024-05-01T10:00:00,5Z"))
+}
@@ -0,0 +1,604 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() { //nolint:gochecknoinits // registers rrule format in the default registry
+	r := RRule{}
+	Default.Add("rrule", &r, IsRRule)
+}
+
+// RRuleFrequency is the FREQ rule part of a recurrence rule.
+type RRuleFrequency uint8
+
+const (
+	// RRuleSecondly repeats every second (or every INTERVAL seconds).
+	RRuleSecondly RRuleFrequency = iota + 1
+	// RRuleMinutely repeats every minute.
+	RRuleMinutely
+	// RRuleHourly repeats every hour.
+	RRuleHourly
+	// RRuleDaily repeats every day.
+	RRuleDaily
+	// RRuleWeekly repeats every week.
+	RRuleWeekly
+	// RRuleMonthly repeats every month.
+	RRuleMonthly
+	// RRuleYearly repeats every year.
+	RRuleYearly
+)
+
+const (
+	rrulePrefix      = "RRULE:"
+	rruleMaxSetPos   = 366
+	rruleMaxOrdinal  = 53
+	rruleMaxMonthDay = 31
+	rruleMaxYearDay  = 366
+	rruleMaxWeekNo   = 53
+	rruleMaxSecond   = 60 // a leap second
+)
+
+//nolint:gochecknoglobals // immutable lookup tables
+var (
+	rruleFrequencyNames = [...]string{"", "SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+	// rruleWeekdayNames is indexed by [time.Weekday].
+	rruleWeekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+)
+
+// String returns the name of the frequency, e.g. "WEEKLY".
+func (f RRuleFrequency) String() string {
+	if int(f) >= len(rruleFrequencyNames) {
+		return ""
+	}
+
+	return rruleFrequencyNames[f]
+}
+
+// RRuleWeekday is an item of the BYDAY rule part of a recurrence rule, e.g. "MO", "1MO" or "-1FR".
+type RRuleWeekday struct {
+	// Ordinal selects the n-th occurrence of the weekday within the month or the year (when negative,
+	// counting from the end). 0 stands for every occurrence.
+	Ordinal int
+
+	// Weekday is the day of the week.
+	Weekday time.Weekday
+}
+
+// String renders the weekday, e.g. "-1FR".
+func (d RRuleWeekday) String() string {
+	if d.Ordinal == 0 {
+		return rruleWeekdayNames[d.Weekday]
+	}
+
+	return strconv.Itoa(d.Ordinal) + rruleWeekdayNames[d.Weekday]
+}
+
+type rruleUntilForm uint8
+
+const (
+	rruleUntilNone rruleUntilForm = iota
+	rruleUntilUTC
+	rruleUntilLocal
+	rruleUntilDate
+)
+
+const (
+	rruleDateLayout      = "20060102"
+	rruleLocalTimeLayout = "20060102T150405"
+	rruleUTCTimeLayout   = "20060102T150405Z"
+)
+
+// IsRRule returns true when the string is a valid recurrence rule, as defined by RFC 5545, section 3.3.10.
+func IsRRule(str string) bool {
+	_, err := ParseRRule(str)
+	return err == nil
+}
+
+// RRule represents an iCalendar recurrence rule (RFC 5545, section 3.3.10), e.g. "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10".
+//
+// A recurrence rule does not carry its start (DTSTART): occurrences are expanded from a start given to [RRule.All]
+// or [RRule.Occurrences].
+//
+// A recurrence rule is marshaled canonically: rule parts are rendered in a fixed order, lists are sorted and
+// default values (INTERVAL=1, WKST=MO) are omitted.
+//
+// swagger:strfmt rrule.
+type RRule struct {
+	freq       RRuleFrequency
+	interval   int
+	count      int
+	until      time.Time // wall clock, in UTC
+	untilForm  rruleUntilForm
+	bySecond   []int
+	byMinute   []int
+	byHour     []int
+	byDay      []RRuleWeekday
+	byMonthDay []int
+	byYearDay  []int
+	byWeekNo   []int
+	byMonth    []int
+	bySetPos   []int
+	weekStart  time.Weekday
+}
+
+// ParseRRule parses a string that represents a recurrence rule, e.g. "FREQ=MONTHLY;BYDAY=-1FR".
+//
+// An optional "RRULE:" prefix is accepted. Rule parts are case-insensitive and may appear in any order.
+//
+// Beyond the syntax, the constraints of RFC 5545 between rule parts are enforced: FREQ is required,
+// COUNT and UNTIL are mutually exclusive, BYWEEKNO is only valid with FREQ=YEARLY, and so on.
+//
+//nolint:gocognit,gocyclo,cyclop // a flat switch over rule parts
+func ParseRRule(data string) (RRule, error) {
+	str := strings.ToUpper(data)
+	str = strings.TrimPrefix(str, rrulePrefix)
+	if str == "" {
+		return RRule{}, rruleError(data, "empty rule")
+	}
+
+	r := RRule{interval: 1, weekStart: time.Monday}
+	seen := make(map[string]bool)
+	for part := range strings.SplitSeq(str, ";") {
+		name, value, found := strings.Cut(part, "=")
+		if !found || value == "" {
+			return RRule{}, rruleError(data, fmt.Sprintf("invalid rule part %q", part))
+		}
+		if seen[name] {
+			return RRule{}, rruleError(data, fmt.Sprintf("duplicate rule part %s", name))
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.freq, err = parseRRuleFrequency(value)
+		case "INTERVAL":
+			r.interval, err = parseRRulePositive(name, value)
+		case "COUNT":
+			r.count, err = parseRRulePositive(name, value)
+		case "UNTIL":
+			r.until, r.untilForm, err = parseRRuleUntil(value)
+		case "BYSECOND":
+			r.bySecond, err = parseRRuleInts(name, value, 0, rruleMaxSecond, false)
+		case "BYMINUTE":
+			r.byMinute, err = parseRRuleInts(name, value, 0, lastMinuteOfHour, false)
+		case "BYHOUR":
+			r.byHour, err = parseRRuleInts(name, value, 0, lastHourOfDay, false)
+		case "BYDAY":
+			r.byDay, err = parseRRuleWeekdays(value)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseRRuleInts(name, value, 1, rruleMaxMonthDay, true)
+		case "BYYEARDAY":
+			r.byYearDay, err = parseRRuleInts(name, value, 1, rruleMaxYearDay, true)
+		case "BYWEEKNO":
+			r.byWeekNo, err = parseRRuleInts(name, value, 1, rruleMaxWeekNo, true)
+		case "BYMONTH":
+			r.byMonth, err = parseRRuleInts(name, value, int(time.January), int(time.December), false)
+		case "BYSETPOS":
+			r.bySetPos, err = parseRRuleInts(name, value, 1, rruleMaxSetPos, true)
+		case "WKST":
+			r.weekStart, err = parseRRuleWeekday(value)
+		default:
+			err = fmt.Errorf("unsupported rule part %s", name)
+		}
+		if err != nil {
+			return RRule{}, rruleError(data, err.Error())
+		}
+	}
+
+	if msg := r.check(); msg != "" {
+		return RRule{}, rruleError(data, msg)
+	}
+
+	return r, nil
+}
+
+// check verifies the constraints between rule parts.
+func (r RRule) check() string {
+	switch {
+	case r.freq == 0:
+		return "FREQ is required"
+	case r.count > 0 && r.untilForm != rruleUntilNone:
+		return "COUNT and UNTIL are mutually exclusive"
+	case len(r.byWeekNo) > 0 && r.freq != RRuleYearly:
+		return "BYWEEKNO is only valid with FREQ=YEARLY"
+	case len(r.byYearDay) > 0 && (r.freq == RRuleDaily || r.freq == RRuleWeekly || r.freq == RRuleMonthly):
+		return "BYYEARDAY is not valid with FREQ=" + r.freq.String()
+	case len(r.byMonthDay) > 0 && r.freq == RRuleWeekly:
+		return "BYMONTHDAY is not valid with FREQ=WEEKLY"
+	case len(r.bySetPos) > 0 && !r.hasByRule():
+		return "BYSETPOS requires another BYxxx rule part"
+	}
+
+	for _, d := range r.byDay {
+		if d.Ordinal == 0 {
+			continue
+		}
+		if r.freq != RRuleMonthly && r.freq != RRuleYearly {
+			return "BYDAY with an ordinal is only valid with FREQ=MONTHLY or FREQ=YEARLY"
+		}
+		if r.freq == RRuleYearly && len(r.byWeekNo) > 0 {
+			return "BYDAY with an ordinal is not valid with BYWEEKNO"
+		}
+	}
+
+	return ""
+}
+
+func (r RRule) hasByRule() bool {
+	return len(r.bySecond) > 0 || len(r.byMinute) > 0 || len(r.byHour) > 0 || len(r.byDay) > 0 ||
+		len(r.byMonthDay) > 0 || len(r.byYearDay) > 0 || len(r.byWeekNo) > 0 || len(r.byMonth) > 0
+}
+
+func rruleError(data, msg string) error {
+	return fmt.Errorf("invalid recurrence rule %q: %s: %w", data, msg, ErrFormat)
+}
+
+func parseRRuleFrequency(value string) (RRuleFrequency, error) {
+	for i, name := range rruleFrequencyNames {
+		if i > 0 && name == value {
+			return RRuleFrequency(i), nil //nolint:gosec // i is bounded by the table
+		}
+	}
+
+	return 0, fmt.Errorf("invalid FREQ %q", value)
+}
+
+func parseRRulePositive(name, value string) (int, error) {
+	v, ok := atoiDigits(value)
+	if !ok || v < 1 {
+		return 0, fmt.Errorf("invalid %s %q: expected a positive integer", name, value)
+	}
+
+	return v, nil
+}
+
+func parseRRuleUntil(value string) (time.Time, rruleUntilForm, error) {
+	if t, err := time.Parse(rruleUTCTimeLayout, value); err == nil {
+		return t, rruleUntilUTC, nil
+	}
+	if t, err := time.Parse(rruleLocalTimeLayout, value); err == nil {
+		return t, rruleUntilLocal, nil
+	}
+	if t, err := time.Parse(rruleDateLayout, value); err == nil {
+		return t, rruleUntilDate, nil
+	}
+
+	return time.Time{}, rruleUntilNone, fmt.Errorf("invalid UNTIL %q: expected a DATE or a DATE-TIME", value)
+}
+
+// parseRRuleInts parses a comma-separated list of integers in [low, high], or in [-high, -low] as well when signed.
+//
+// The result is sorted, without duplicates.
+func parseRRuleInts(name, value string, low, high int, signed bool) ([]int, error) {
+	var values []int
+	for item := range strings.SplitSeq(value, ",") {
+		digits := item
+		sign := 1
+		if signed && digits != "" && (digits[0] == '+' || digits[0] == '-') {
+			if digits[0] == '-' {
+				sign = -1
+			}
+			digits = digits[1:]
+		}
+
+		v, ok := atoiDigits(digits)
+		if !ok || v < low || v > high {
+			return nil, fmt.Errorf("invalid %s value %q", name, item)
+		}
+		values = append(values, sign*v)
+	}
+	slices.Sort(values)
+
+	return slices.Compact(values), nil
+}
+
+func parseRRuleWeekday(value string) (time.Weekday, error) {
+	for i, name := range rruleWeekdayNames {
+		if name == value {
+			return time.Weekday(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid weekday %q", value)
+}
+
+// parseRRuleWeekdays parses the BYDAY rule part, e.g. "MO,WE" or "1MO,-1FR".
+//
+// The result is sorted by ordinal then weekday (starting on Monday), without duplicates.
+func parseRRuleWeekdays(value string) ([]RRuleWeekday, error) {
+	const weekdayLen = 2
+
+	var days []RRuleWeekday
+	for item := range strings.SplitSeq(value, ",") {
+		if len(item) < weekdayLen {
+			return nil, fmt.Errorf("invalid BYDAY value %q", item)
+		}
+		weekday, err := parseRRuleWeekday(item[len(item)-weekdayLen:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid BYDAY value %q", item)
+		}
+
+		var ordinal int
+		if prefix := item[:len(item)-weekdayLen]; prefix != "" {
+			ordinals, err := parseRRuleInts("BYDAY", prefix, 1, rruleMaxOrdinal, true)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYDAY value %q", item)
+			}
+			ordinal = ordinals[0]
+		}
+		days = append(days, RRuleWeekday{Ordinal: ordinal, Weekday: weekday})
+	}
+
+	slices.SortFunc(days, func(a, b RRuleWeekday) int {
+		if c := cmp.Compare(a.Ordinal, b.Ordinal); c != 0 {
+			return c
+		}
+		return cmp.Compare(daysFromMonday(a.Weekday), daysFromMonday(b.Weekday))
+	})
+
+	return slices.Compact(days), nil
+}
+
+func daysFromMonday(d time.Weekday) int {
+	return (int(d) + daysInWeek - 1) % daysInWeek
+}
+
+// Frequency returns the FREQ rule part.
+func (r RRule) Frequency() RRuleFrequency {
+	return r.freq
+}
+
+// Interval returns the INTERVAL rule part, which defaults to 1.
+func (r RRule) Interval() int {
+	return max(r.interval, 1)
+}
+
+// Count returns the COUNT rule part, or 0 when the rule is not bounded by a count.
+func (r RRule) Count() int {
+	return r.count
+}
+
+// Until returns the UNTIL rule part, and false when the rule is not bounded by an end.
+//
+// An UNTIL expressed as a local date-time or as a date is resolved in the given location, which is
+// the location of the start of the recurrence. An UNTIL date stands for the last instant of that day.
+func (r RRule) Until(loc *time.Location) (DateTime, bool) {
+	switch r.untilForm {
+	case rruleUntilUTC:
+		return DateTime(r.until), true
+	case rruleUntilLocal:
+		return DateTime(wallClockIn(r.until, loc)), true
+	case rruleUntilDate:
+		return DateTime(wallClockIn(r.until, loc).AddDate(0, 0, 1).Add(-time.Nanosecond)), true
+	default:
+		return DateTime{}, false
+	}
+}
+
+// BySecond returns the BYSECOND rule part.
+func (r RRule) BySecond() []int { return slices.Clone(r.bySecond) }
+
+// ByMinute returns the BYMINUTE rule part.
+func (r RRule) ByMinute() []int { return slices.Clone(r.byMinute) }
+
+// ByHour returns the BYHOUR rule part.
+func (r RRule) ByHour() []int { return slices.Clone(r.byHour) }
+
+// ByDay returns the BYDAY rule part.
+func (r RRule) ByDay() []RRuleWeekday { return slices.Clone(r.byDay) }
+
+// ByMonthDay returns the BYMONTHDAY rule part.
+func (r RRule) ByMonthDay() []int { return slices.Clone(r.byMonthDay) }
+
+// ByYearDay returns the BYYEARDAY rule part.
+func (r RRule) ByYearDay() []int { return slices.Clone(r.byYearDay) }
+
+// ByWeekNo returns the BYWEEKNO rule part.
+func (r RRule) ByWeekNo() []int { return slices.Clone(r.byWeekNo) }
+
+// ByMonth returns the BYMONTH rule part.
+func (r RRule) ByMonth() []time.Month {
+	months := make([]time.Month, 0, len(r.byMonth))
+	for _, m := range r.byMonth {
+		months = append(months, time.Month(m))
+	}
+
+	return months
+}
+
+// BySetPos returns the BYSETPOS rule part.
+func (r RRule) BySetPos() []int { return slices.Clone(r.bySetPos) }
+
+// WeekStart returns the WKST rule part, which defaults to Monday.
+func (r RRule) WeekStart() time.Weekday {
+	if r.freq == 0 {
+		return time.Monday
+	}
+
+	return r.weekStart
+}
+
+// IsZero returns whether the recurrence rule is a zero value.
+func (r RRule) IsZero() bool {
+	return r.freq == 0
+}
+
+// Equal checks if two [RRule] instances are the same rule, i.e. have the same canonical representation.
+func (r RRule) Equal(other RRule) bool {
+	return r.String() == other.String()
+}
+
+// String renders the recurrence rule canonically, e.g. "FREQ=WEEKLY;COUNT=10;BYDAY=MO,WE".
+//
+// The zero [RRule] renders as an empty string.
+func (r RRule) String() string {
+	if r.IsZero() {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("FREQ=")
+	b.WriteString(r.freq.String())
+	if r.interval > 1 {
+		b.WriteString(";INTERVAL=")
+		b.WriteString(strconv.Itoa(r.interval))
+	}
+	if r.count > 0 {
+		b.WriteString(";COUNT=")
+		b.WriteString(strconv.Itoa(r.count))
+	}
+	switch r.untilForm {
+	case rruleUntilUTC:
+		b.WriteString(";UNTIL=")
+		b.WriteString(r.until.Format(rruleUTCTimeLayout))
+	case rruleUntilLocal:
+		b.WriteString(";UNTIL=")
+		b.WriteString(r.until.Format(rruleLocalTimeLayout))
+	case rruleUntilDate:
+		b.WriteString(";UNTIL=")
+		b.WriteString(r.until.Format(rruleDateLayout))
+	default:
+	}
+	writeRRuleInts(&b, "BYSECOND", r.bySecond)
+	writeRRuleInts(&b, "BYMINUTE", r.byMinute)
+	writeRRuleInts(&b, "BYHOUR", r.byHour)
+	if len(r.byDay) > 0 {
+		b.WriteString(";BYDAY=")
+		for i, d := range r.byDay {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(d.String())
+		}
+	}
+	writeRRuleInts(&b, "BYMONTHDAY", r.byMonthDay)
+	writeRRuleInts(&b, "BYYEARDAY", r.byYearDay)
+	writeRRuleInts(&b, "BYWEEKNO", r.byWeekNo)
+	writeRRuleInts(&b, "BYMONTH", r.byMonth)
+	writeRRuleInts(&b, "BYSETPOS", r.bySetPos)
+	if r.weekStart != time.Monday {
+		b.WriteString(";WKST=")
+		b.WriteString(rruleWeekdayNames[r.weekStart])
+	}
+
+	return b.String()
+}
+
+func writeRRuleInts(b *strings.Builder, name string, values []int) {
+	if len(values) == 0 {
+		return
+	}
+
+	b.WriteByte(';')
+	b.WriteString(name)
+	b.WriteByte('=')
+	for i, v := range values {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Itoa(v))
+	}
+}
+
+// MarshalText serializes this recurrence rule to string.
+func (r RRule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText parses a text representation into a recurrence rule.
+func (r *RRule) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = RRule{}
+		return nil
+	}
+	v, err := ParseRRule(string(text))
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+// Scan scans a [RRule] value from database driver type.
+func (r *RRule) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return r.UnmarshalText(v)
+	case string:
+		return r.UnmarshalText([]byte(v))
+	case nil:
+		*r = RRule{}
+		return nil
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.RRule from: %#v: %w", v, ErrFormat)
+	}
+}
+
+// Value converts [RRule] to a primitive value ready to written to a database.
+func (r RRule) Value() (driver.Value, error) {
+	return driver.Value(r.String()), nil
+}
+
+// MarshalJSON returns the [RRule] as JSON.
+func (r RRule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON sets the [RRule] from JSON.
+func (r *RRule) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return r.UnmarshalText([]byte(str))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (r *RRule) DeepCopyInto(out *RRule) {
+	*out = *r
+	out.bySecond = slices.Clone(r.bySecond)
+	out.byMinute = slices.Clone(r.byMinute)
+	out.byHour = slices.Clone(r.byHour)
+	out.byDay = slices.Clone(r.byDay)
+	out.byMonthDay = slices.Clone(r.byMonthDay)
+	out.byYearDay = slices.Clone(r.byYearDay)
+	out.byWeekNo = slices.Clone(r.byWeekNo)
+	out.byMonth = slices.Clone(r.byMonth)
+	out.bySetPos = slices.Clone(r.bySetPos)
+}
+
+// DeepCopy copies the receiver into a new [RRule].
+func (r *RRule) DeepCopy() *RRule {
+	if r == nil {
+		return nil
+	}
+	out := new(RRule)
+	r.DeepCopyInto(out)
+	return out
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (r RRule) GobEncode() ([]byte, error) {
+	return r.MarshalText()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (r *RRule) GobDecode(data []byte) error {
+	return r.UnmarshalText(data)
+}
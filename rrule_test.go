@@ -0,0 +1,469 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+var (
+	_ sql.Scanner   = &RRule{}
+	_ driver.Valuer = RRule{}
+)
+
+func TestParseRRule(t *testing.T) {
+	for _, tc := range []struct {
+		rule      string
+		canonical string
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY"},
+		{"FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10", "FREQ=WEEKLY;COUNT=10;BYDAY=MO,WE"},
+		{"rrule:freq=weekly;byday=we,mo,we;count=10", "FREQ=WEEKLY;COUNT=10;BYDAY=MO,WE"},
+		{"FREQ=WEEKLY;INTERVAL=1;WKST=MO;BYDAY=SU,MO", "FREQ=WEEKLY;BYDAY=MO,SU"},
+		{"FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH", "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;WKST=SU"},
+		{"FREQ=MONTHLY;BYDAY=-1FR,+1MO", "FREQ=MONTHLY;BYDAY=-1FR,1MO"},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1,1,15", "FREQ=MONTHLY;BYMONTHDAY=-1,1,15"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{"FREQ=YEARLY;BYMONTH=7,6;COUNT=10", "FREQ=YEARLY;COUNT=10;BYMONTH=6,7"},
+		{"FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO", "FREQ=YEARLY;BYDAY=MO;BYWEEKNO=20"},
+		{"FREQ=YEARLY;BYYEARDAY=1,100,200", "FREQ=YEARLY;BYYEARDAY=1,100,200"},
+		{"FREQ=DAILY;UNTIL=19971224T000000Z", "FREQ=DAILY;UNTIL=19971224T000000Z"},
+		{"FREQ=DAILY;UNTIL=19971224T000000", "FREQ=DAILY;UNTIL=19971224T000000"},
+		{"FREQ=DAILY;UNTIL=19971224", "FREQ=DAILY;UNTIL=19971224"},
+		{"FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10,11", "FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10,11"},
+		{"FREQ=SECONDLY;BYSECOND=0,60", "FREQ=SECONDLY;BYSECOND=0,60"},
+	} {
+		t.Run(tc.rule, func(t *testing.T) {
+			r, err := ParseRRule(tc.rule)
+			require.NoError(t, err)
+			assert.EqualT(t, tc.canonical, r.String())
+			assert.TrueT(t, IsRRule(tc.rule))
+
+			again, err := ParseRRule(r.String())
+			require.NoError(t, err)
+			assert.TrueT(t, r.Equal(again))
+		})
+	}
+
+	for _, rule := range []string{
+		"",
+		"RRULE:",
+		"COUNT=10",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;",
+		"FREQ=DAILY;COUNT",
+		"FREQ=DAILY;COUNT=",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=-1",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;COUNT=10;UNTIL=19971224",
+		"FREQ=DAILY;UNTIL=1997-12-24",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;BYMINUTE=60",
+		"FREQ=DAILY;BYSECOND=61",
+		"FREQ=DAILY;BYHOUR=-1",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=YEARLY;BYYEARDAY=367",
+		"FREQ=YEARLY;BYWEEKNO=54",
+		"FREQ=MONTHLY;BYWEEKNO=20",
+		"FREQ=MONTHLY;BYYEARDAY=100",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=YEARLY;BYWEEKNO=20;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=0MO",
+		"FREQ=MONTHLY;BYDAY=54MO",
+		"FREQ=MONTHLY;BYDAY=MONDAY",
+		"FREQ=MONTHLY;BYDAY=M",
+		"FREQ=MONTHLY;BYSETPOS=1",
+		"FREQ=MONTHLY;BYDAY=MO;BYSETPOS=0",
+		"FREQ=DAILY;WKST=XX",
+		"FREQ=DAILY;X-NAME=1",
+		"FREQ=DAILY;BYHOUR=1,,2",
+	} {
+		assert.FalseTf(t, IsRRule(rule), "expected %q to be invalid", rule)
+		_, err := ParseRRule(rule)
+		require.ErrorIs(t, err, ErrFormat)
+	}
+}
+
+func TestRRule_Accessors(t *testing.T) {
+	r, err := ParseRRule("FREQ=YEARLY;INTERVAL=2;UNTIL=20301231;BYDAY=-1FR;BYMONTH=3,12;BYHOUR=9;BYMINUTE=30;BYSECOND=15;WKST=SU")
+	require.NoError(t, err)
+
+	assert.EqualT(t, RRuleYearly, r.Frequency())
+	assert.EqualT(t, "YEARLY", r.Frequency().String())
+	assert.EqualT(t, 2, r.Interval())
+	assert.EqualT(t, 0, r.Count())
+	assert.Equal(t, []RRuleWeekday{{Ordinal: -1, Weekday: time.Friday}}, r.ByDay())
+	assert.EqualT(t, "-1FR", r.ByDay()[0].String())
+	assert.Equal(t, []time.Month{time.March, time.December}, r.ByMonth())
+	assert.Equal(t, []int{9}, r.ByHour())
+	assert.Equal(t, []int{30}, r.ByMinute())
+	assert.Equal(t, []int{15}, r.BySecond())
+	assert.Empty(t, r.ByMonthDay())
+	assert.Empty(t, r.ByYearDay())
+	assert.Empty(t, r.ByWeekNo())
+	assert.Empty(t, r.BySetPos())
+	assert.EqualT(t, time.Sunday, r.WeekStart())
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	until, ok := r.Until(paris)
+	require.TrueT(t, ok)
+	assert.EqualT(t, "2030-12-31T23:59:59+01:00", time.Time(until).Format(time.RFC3339))
+
+	t.Run("should not leak internal state", func(t *testing.T) {
+		r.ByHour()[0] = 10
+		assert.Equal(t, []int{9}, r.ByHour())
+	})
+
+	t.Run("with zero value", func(t *testing.T) {
+		var zero RRule
+		assert.TrueT(t, zero.IsZero())
+		assert.EqualT(t, "", zero.String())
+		assert.EqualT(t, 1, zero.Interval())
+		assert.EqualT(t, time.Monday, zero.WeekStart())
+		_, ok := zero.Until(time.UTC)
+		assert.FalseT(t, ok)
+		assert.Empty(t, zero.Occurrences(DateTime(time.Now()), 3))
+	})
+}
+
+func TestRRule_Occurrences(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	at := func(year int, month time.Month, day, hour, minute int) DateTime {
+		return DateTime(time.Date(year, month, day, hour, minute, 0, 0, newYork))
+	}
+
+	// examples from RFC 5545, section 3.8.5.3
+	for _, tc := range []struct {
+		name     string
+		rule     string
+		start    DateTime
+		n        int
+		expected []string
+	}{
+		{
+			name:  "daily for 10 occurrences",
+			rule:  "FREQ=DAILY;COUNT=10",
+			start: at(1997, time.September, 2, 9, 0),
+			n:     20,
+			expected: []string{
+				"1997-09-02T09:00:00-04:00", "1997-09-03T09:00:00-04:00", "1997-09-04T09:00:00-04:00",
+				"1997-09-05T09:00:00-04:00", "1997-09-06T09:00:00-04:00", "1997-09-07T09:00:00-04:00",
+				"1997-09-08T09:00:00-04:00", "1997-09-09T09:00:00-04:00", "1997-09-10T09:00:00-04:00",
+				"1997-09-11T09:00:00-04:00",
+			},
+		},
+		{
+			name:     "every other day",
+			rule:     "FREQ=DAILY;INTERVAL=2",
+			start:    at(1997, time.September, 2, 9, 0),
+			n:        3,
+			expected: []string{"1997-09-02T09:00:00-04:00", "1997-09-04T09:00:00-04:00", "1997-09-06T09:00:00-04:00"},
+		},
+		{
+			name:  "weekly on Tuesday and Thursday for five weeks",
+			rule:  "FREQ=WEEKLY;UNTIL=19971007T000000Z;WKST=SU;BYDAY=TU,TH",
+			start: at(1997, time.September, 2, 9, 0),
+			n:     20,
+			expected: []string{
+				"1997-09-02T09:00:00-04:00", "1997-09-04T09:00:00-04:00", "1997-09-09T09:00:00-04:00",
+				"1997-09-11T09:00:00-04:00", "1997-09-16T09:00:00-04:00", "1997-09-18T09:00:00-04:00",
+				"1997-09-23T09:00:00-04:00", "1997-09-25T09:00:00-04:00", "1997-09-30T09:00:00-04:00",
+				"1997-10-02T09:00:00-04:00",
+			},
+		},
+		{
+			name:  "every other week, with WKST=MO",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+			start: at(1997, time.August, 5, 9, 0),
+			n:     20,
+			expected: []string{
+				"1997-08-05T09:00:00-04:00", "1997-08-10T09:00:00-04:00",
+				"1997-08-19T09:00:00-04:00", "1997-08-24T09:00:00-04:00",
+			},
+		},
+		{
+			name:  "every other week, with WKST=SU",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			start: at(1997, time.August, 5, 9, 0),
+			n:     20,
+			expected: []string{
+				"1997-08-05T09:00:00-04:00", "1997-08-17T09:00:00-04:00",
+				"1997-08-19T09:00:00-04:00", "1997-08-31T09:00:00-04:00",
+			},
+		},
+		{
+			name:  "monthly on the second-to-last Monday for 6 months",
+			rule:  "FREQ=MONTHLY;COUNT=6;BYDAY=-2MO",
+			start: at(1997, time.September, 22, 9, 0),
+			n:     20,
+			expected: []string{
+				"1997-09-22T09:00:00-04:00", "1997-10-20T09:00:00-04:00", "1997-11-17T09:00:00-05:00",
+				"1997-12-22T09:00:00-05:00", "1998-01-19T09:00:00-05:00", "1998-02-16T09:00:00-05:00",
+			},
+		},
+		{
+			name:  "monthly on the last workday",
+			rule:  "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			start: at(1997, time.September, 29, 9, 0),
+			n:     4,
+			expected: []string{
+				"1997-09-30T09:00:00-04:00", "1997-10-31T09:00:00-05:00",
+				"1997-11-28T09:00:00-05:00", "1997-12-31T09:00:00-05:00",
+			},
+		},
+		{
+			name:  "every Friday the 13th",
+			rule:  "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13",
+			start: at(1997, time.September, 2, 9, 0),
+			n:     3,
+			expected: []string{
+				"1998-02-13T09:00:00-05:00", "1998-03-13T09:00:00-05:00", "1998-11-13T09:00:00-05:00",
+			},
+		},
+		{
+			name:  "monthly on the 31st, skipping shorter months",
+			rule:  "FREQ=MONTHLY",
+			start: at(2024, time.January, 31, 9, 0),
+			n:     3,
+			expected: []string{
+				"2024-01-31T09:00:00-05:00", "2024-03-31T09:00:00-04:00", "2024-05-31T09:00:00-04:00",
+			},
+		},
+		{
+			name:  "monthly on the last day",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: at(2024, time.January, 15, 9, 0),
+			n:     3,
+			expected: []string{
+				"2024-01-31T09:00:00-05:00", "2024-02-29T09:00:00-05:00", "2024-03-31T09:00:00-04:00",
+			},
+		},
+		{
+			name:  "yearly in June and July for 10 occurrences",
+			rule:  "FREQ=YEARLY;COUNT=10;BYMONTH=6,7",
+			start: at(1997, time.June, 10, 9, 0),
+			n:     20,
+			expected: []string{
+				"1997-06-10T09:00:00-04:00", "1997-07-10T09:00:00-04:00", "1998-06-10T09:00:00-04:00",
+				"1998-07-10T09:00:00-04:00", "1999-06-10T09:00:00-04:00", "1999-07-10T09:00:00-04:00",
+				"2000-06-10T09:00:00-04:00", "2000-07-10T09:00:00-04:00", "2001-06-10T09:00:00-04:00",
+				"2001-07-10T09:00:00-04:00",
+			},
+		},
+		{
+			name:  "yearly on the 20th Monday",
+			rule:  "FREQ=YEARLY;BYDAY=20MO",
+			start: at(1997, time.May, 19, 9, 0),
+			n:     3,
+			expected: []string{
+				"1997-05-19T09:00:00-04:00", "1998-05-18T09:00:00-04:00", "1999-05-17T09:00:00-04:00",
+			},
+		},
+		{
+			name:  "Monday of week number 20",
+			rule:  "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO",
+			start: at(1997, time.May, 12, 9, 0),
+			n:     3,
+			expected: []string{
+				"1997-05-12T09:00:00-04:00", "1998-05-11T09:00:00-04:00", "1999-05-17T09:00:00-04:00",
+			},
+		},
+		{
+			name:  "every Thursday in March",
+			rule:  "FREQ=YEARLY;BYMONTH=3;BYDAY=TH",
+			start: at(1997, time.March, 13, 9, 0),
+			n:     4,
+			expected: []string{
+				"1997-03-13T09:00:00-05:00", "1997-03-20T09:00:00-05:00",
+				"1997-03-27T09:00:00-05:00", "1998-03-05T09:00:00-05:00",
+			},
+		},
+		{
+			name:  "every 3 hours",
+			rule:  "FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T210000Z",
+			start: at(1997, time.September, 2, 9, 0),
+			n:     20,
+			expected: []string{
+				"1997-09-02T09:00:00-04:00", "1997-09-02T12:00:00-04:00", "1997-09-02T15:00:00-04:00",
+			},
+		},
+		{
+			name:  "every 20 minutes from 9:00 to 16:40",
+			rule:  "FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10,11,12,13,14,15,16",
+			start: at(1997, time.September, 2, 9, 0),
+			n:     26,
+			expected: []string{
+				"1997-09-02T09:00:00-04:00", "1997-09-02T09:20:00-04:00", "1997-09-02T09:40:00-04:00",
+				"1997-09-02T10:00:00-04:00", "1997-09-02T10:20:00-04:00", "1997-09-02T10:40:00-04:00",
+				"1997-09-02T11:00:00-04:00", "1997-09-02T11:20:00-04:00", "1997-09-02T11:40:00-04:00",
+				"1997-09-02T12:00:00-04:00", "1997-09-02T12:20:00-04:00", "1997-09-02T12:40:00-04:00",
+				"1997-09-02T13:00:00-04:00", "1997-09-02T13:20:00-04:00", "1997-09-02T13:40:00-04:00",
+				"1997-09-02T14:00:00-04:00", "1997-09-02T14:20:00-04:00", "1997-09-02T14:40:00-04:00",
+				"1997-09-02T15:00:00-04:00", "1997-09-02T15:20:00-04:00", "1997-09-02T15:40:00-04:00",
+				"1997-09-02T16:00:00-04:00", "1997-09-02T16:20:00-04:00", "1997-09-02T16:40:00-04:00",
+				"1997-09-03T09:00:00-04:00", "1997-09-03T09:20:00-04:00",
+			},
+		},
+		{
+			name:  "daily at 9:00 and 9:30",
+			rule:  "FREQ=DAILY;BYMINUTE=0,30",
+			start: at(1997, time.September, 2, 9, 15),
+			n:     3,
+			expected: []string{
+				"1997-09-02T09:30:00-04:00", "1997-09-03T09:00:00-04:00", "1997-09-03T09:30:00-04:00",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := ParseRRule(tc.rule)
+			require.NoError(t, err)
+
+			occurrences := r.Occurrences(tc.start, tc.n)
+			actual := make([]string, 0, len(occurrences))
+			for _, occurrence := range occurrences {
+				actual = append(actual, time.Time(occurrence).Format(time.RFC3339))
+				assert.EqualT(t, newYork, time.Time(occurrence).Location())
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("should return nil when n <= 0", func(t *testing.T) {
+		r, err := ParseRRule("FREQ=DAILY")
+		require.NoError(t, err)
+		assert.Nil(t, r.Occurrences(at(1997, time.September, 2, 9, 0), 0))
+		assert.Nil(t, r.Occurrences(at(1997, time.September, 2, 9, 0), -1))
+	})
+}
+
+func TestRRule_Until(t *testing.T) {
+	start := DateTime(time.Date(1997, time.September, 2, 9, 0, 0, 0, time.UTC))
+
+	for _, tc := range []struct {
+		rule string
+		last string
+		n    int
+	}{
+		{"FREQ=DAILY;UNTIL=19971224", "1997-12-24T09:00:00Z", 114},
+		{"FREQ=DAILY;UNTIL=19971224T090000", "1997-12-24T09:00:00Z", 114},
+		{"FREQ=DAILY;UNTIL=19971224T085959Z", "1997-12-23T09:00:00Z", 113},
+	} {
+		t.Run(tc.rule, func(t *testing.T) {
+			r, err := ParseRRule(tc.rule)
+			require.NoError(t, err)
+
+			occurrences := r.Occurrences(start, 1000)
+			require.Len(t, occurrences, tc.n)
+			assert.EqualT(t, tc.last, time.Time(occurrences[len(occurrences)-1]).Format(time.RFC3339))
+		})
+	}
+}
+
+func TestRRule_ExDates(t *testing.T) {
+	start := DateTime(time.Date(1997, time.September, 2, 9, 0, 0, 0, time.UTC))
+	r, err := ParseRRule("FREQ=DAILY;COUNT=5")
+	require.NoError(t, err)
+
+	exdate := DateTime(time.Date(1997, time.September, 3, 5, 0, 0, 0, time.FixedZone("", -4*60*60)))
+	occurrences := r.Occurrences(start, 10, exdate)
+	require.Len(t, occurrences, 4)
+	for i, expected := range []int{2, 4, 5, 6} {
+		assert.EqualT(t, expected, time.Time(occurrences[i]).Day())
+	}
+
+	t.Run("should stop when breaking out of the loop", func(t *testing.T) {
+		r, err := ParseRRule("FREQ=SECONDLY")
+		require.NoError(t, err)
+
+		var n int
+		for range r.All(start) {
+			n++
+			if n == 3 {
+				break
+			}
+		}
+		assert.EqualT(t, 3, n)
+	})
+}
+
+func TestRRule_Serialization(t *testing.T) {
+	const canonical = "FREQ=WEEKLY;COUNT=10;BYDAY=MO,WE"
+	r, err := ParseRRule("BYDAY=WE,MO;FREQ=WEEKLY;COUNT=10")
+	require.NoError(t, err)
+
+	t.Run("with JSON", func(t *testing.T) {
+		b, err := r.MarshalJSON()
+		require.NoError(t, err)
+		assert.EqualT(t, `"`+canonical+`"`, string(b))
+
+		var out RRule
+		require.NoError(t, out.UnmarshalJSON(b))
+		assert.TrueT(t, r.Equal(out))
+
+		require.NoError(t, out.UnmarshalJSON([]byte(jsonNull)))
+		assert.TrueT(t, r.Equal(out))
+		require.ErrorIs(t, out.UnmarshalJSON([]byte(`"FREQ=NEVER"`)), ErrFormat)
+	})
+
+	t.Run("with Scan and Value", func(t *testing.T) {
+		for _, value := range []any{canonical, []byte(canonical)} {
+			var out RRule
+			require.NoError(t, out.Scan(value))
+			assert.TrueT(t, r.Equal(out))
+		}
+
+		out := r
+		require.NoError(t, out.Scan(nil))
+		assert.TrueT(t, out.IsZero())
+		require.ErrorIs(t, out.Scan(int64(1)), ErrFormat)
+
+		v, err := r.Value()
+		require.NoError(t, err)
+		assert.EqualValues(t, canonical, v)
+	})
+
+	t.Run("with BSON", func(t *testing.T) {
+		b, err := r.MarshalBSON()
+		require.NoError(t, err)
+
+		var out RRule
+		require.NoError(t, out.UnmarshalBSON(b))
+		assert.TrueT(t, r.Equal(out))
+	})
+
+	t.Run("with registry", func(t *testing.T) {
+		assert.TrueT(t, Default.ContainsName("rrule"))
+		assert.TrueT(t, Default.Validates("rrule", "FREQ=MONTHLY;BYDAY=-1FR"))
+		assert.FalseT(t, Default.Validates("rrule", "FREQ=MONTHLY;BYDAY=-1XX"))
+
+		v, err := Default.Parse("rrule", "rrule:freq=daily")
+		require.NoError(t, err)
+		assert.EqualT(t, "FREQ=DAILY", v.(*RRule).String())
+	})
+
+	t.Run("with DeepCopy", func(t *testing.T) {
+		out := r.DeepCopy()
+		assert.TrueT(t, r.Equal(*out))
+
+		out.byDay[0].Weekday = time.Friday
+		assert.EqualT(t, canonical, r.String())
+
+		var inNil *RRule
+		assert.Nil(t, inNil.DeepCopy())
+	})
+}
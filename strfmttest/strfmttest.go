@@ -0,0 +1,456 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+// Package strfmttest checks that format types behave consistently across encodings.
+//
+// Given a format type and samples of valid and invalid values, [Check] and [Run] verify that
+// valid values round trip through text, JSON, SQL, BSON, gob and binary encodings, deep copies,
+// the mapstructure decode hook and [strfmt.Registry.Parse], and that invalid values are rejected.
+//
+// Typical usage, for a format registered in [strfmt.Default]:
+//
+//	func TestZipCode(t *testing.T) {
+//		strfmttest.Run[ZipCode](t, strfmttest.Case{
+//			Name:    "zipcode",
+//			Valid:   []string{"12345"},
+//			Invalid: []string{"1234", "abcde"},
+//		})
+//	}
+//
+// BSON encodings are checked with the minimal codec of strfmt and, when enabled, with the codec of
+// the MongoDB driver (see github.com/go-openapi/strfmt/enable/mongodb). The active codec is swapped
+// during the check: tests relying on BSON must not run in parallel with [Check] or [Run].
+package strfmttest
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/internal/bsonlite"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+// Case describes the samples of a format type to check.
+type Case struct {
+	// Name is the name of the format in the registry, e.g. "email".
+	// The registry is not checked when empty.
+	Name string
+
+	// Registry is the registry of the format. It defaults to [strfmt.Default].
+	Registry strfmt.Registry
+
+	// Valid are valid values of the format, as text.
+	Valid []string
+
+	// Invalid are values the format rejects, as text.
+	Invalid []string
+}
+
+// Pointer is the constraint on the pointer to a format type.
+type Pointer[T any] interface {
+	*T
+	strfmt.Format
+}
+
+// Run checks a format type like [Check] does, and reports each problem as an error of the test.
+func Run[T any, PT Pointer[T]](t testing.TB, c Case) {
+	t.Helper()
+
+	for _, err := range Check[T, PT](c) {
+		t.Error(err)
+	}
+}
+
+// Check verifies that a format type is consistent across encodings, and returns the problems found.
+//
+// Each valid value is unmarshaled from text, then:
+//
+//   - it is checked with its Validate method, if any
+//   - it round trips through text, JSON, SQL (Value then Scan), BSON, gob and binary encodings
+//     (the latter being checked when the type implements them)
+//   - its DeepCopy method, if any, returns an equal value
+//   - it is registered, validated and parsed by the registry, and decoded by its mapstructure hook
+//
+// A round trip must produce a value which is equal to the original one, by its Equal method if any
+// and by reflection otherwise, and which has the same text.
+//
+// Each invalid value must be rejected by the registry, and either by UnmarshalText or by the Validate method.
+//
+// The handling of nulls is checked too: a JSON null must leave a value unchanged,
+// and a SQL NULL must scan as the zero value.
+func Check[T any, PT Pointer[T]](c Case) []error {
+	if c.Registry == nil {
+		c.Registry = strfmt.Default
+	}
+
+	k := &checker[T, PT]{Case: c}
+	k.checkRegistry()
+	k.checkNulls()
+	for _, sample := range c.Valid {
+		k.checkValid(sample)
+	}
+	for _, sample := range c.Invalid {
+		k.checkInvalid(sample)
+	}
+
+	return k.errs
+}
+
+type (
+	bsonMarshaler interface {
+		MarshalBSON() ([]byte, error)
+	}
+
+	bsonUnmarshaler interface {
+		UnmarshalBSON(data []byte) error
+	}
+
+	validator interface {
+		Validate() error
+	}
+
+	zeroer interface {
+		IsZero() bool
+	}
+)
+
+type checker[T any, PT Pointer[T]] struct {
+	Case
+
+	errs []error
+}
+
+func (k *checker[T, PT]) errorf(format string, args ...any) {
+	k.errs = append(k.errs, fmt.Errorf(format, args...))
+}
+
+func (k *checker[T, PT]) checkRegistry() {
+	if k.Name == "" {
+		return
+	}
+
+	tpe, ok := k.Registry.GetType(k.Name)
+	switch {
+	case !ok:
+		k.errorf("registry: format %q is not registered", k.Name)
+		k.Name = "" // skips the other checks of the registry
+	case tpe != reflect.TypeFor[T]():
+		k.errorf("registry: format %q is registered with type %v, not %v", k.Name, tpe, reflect.TypeFor[T]())
+	}
+}
+
+func (k *checker[T, PT]) checkNulls() {
+	if len(k.Valid) == 0 {
+		return
+	}
+	var value T
+	if err := PT(&value).UnmarshalText([]byte(k.Valid[0])); err != nil {
+		return // reported with the valid samples
+	}
+
+	before := value
+	if err := json.Unmarshal([]byte("null"), &value); err != nil {
+		k.errorf("json: null is rejected: %v", err)
+	} else if !k.same(before, value) {
+		k.errorf("json: null changes %q into %q", k.text(before), k.text(value))
+	}
+
+	scanner, ok := any(PT(&value)).(sql.Scanner)
+	if !ok {
+		return
+	}
+	if err := scanner.Scan(nil); err != nil {
+		k.errorf("sql: NULL is rejected: %v", err)
+		return
+	}
+	if !isZero(value) {
+		k.errorf("sql: NULL scans as %q, not as the zero value", k.text(value))
+	}
+}
+
+func (k *checker[T, PT]) checkValid(sample string) {
+	var value T
+	if err := PT(&value).UnmarshalText([]byte(sample)); err != nil {
+		k.errorf("text: valid %q is rejected: %v", sample, err)
+		return
+	}
+	if v, ok := any(value).(validator); ok {
+		if err := v.Validate(); err != nil {
+			k.errorf("validate: valid %q is rejected: %v", sample, err)
+		}
+	}
+
+	k.checkText(value)
+	k.checkJSON(value)
+	k.checkSQL(value)
+	k.checkBSON(value)
+	k.checkGob(value)
+	k.checkBinary(value)
+	k.checkDeepCopy(value)
+
+	if k.Name == "" {
+		return
+	}
+	if !k.Registry.Validates(k.Name, sample) {
+		k.errorf("registry: valid %q is rejected", sample)
+	}
+	k.checkParse(sample, value)
+	k.checkMapStructure(sample, value)
+}
+
+func (k *checker[T, PT]) checkInvalid(sample string) {
+	if k.Name != "" && k.Registry.Validates(k.Name, sample) {
+		k.errorf("registry: invalid %q is accepted", sample)
+	}
+
+	var value T
+	if err := PT(&value).UnmarshalText([]byte(sample)); err != nil {
+		return
+	}
+	v, ok := any(value).(validator)
+	if !ok {
+		k.errorf("text: invalid %q is accepted, and the type has no Validate method", sample)
+		return
+	}
+	if err := v.Validate(); err == nil {
+		k.errorf("validate: invalid %q is accepted", sample)
+	}
+}
+
+func (k *checker[T, PT]) checkText(value T) {
+	text, err := PT(&value).MarshalText()
+	if err != nil {
+		k.errorf("text: %q cannot be marshaled: %v", k.text(value), err)
+		return
+	}
+
+	var back T
+	if err := PT(&back).UnmarshalText(text); err != nil {
+		k.errorf("text: %q cannot be unmarshaled: %v", text, err)
+		return
+	}
+	k.compare("text", value, back)
+}
+
+func (k *checker[T, PT]) checkJSON(value T) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		k.errorf("json: %q cannot be marshaled: %v", k.text(value), err)
+		return
+	}
+
+	var back T
+	if err := json.Unmarshal(data, &back); err != nil {
+		k.errorf("json: %s cannot be unmarshaled: %v", data, err)
+		return
+	}
+	k.compare("json", value, back)
+}
+
+func (k *checker[T, PT]) checkSQL(value T) {
+	valuer, ok := any(value).(driver.Valuer)
+	if !ok {
+		return
+	}
+	var back T
+	scanner, ok := any(PT(&back)).(sql.Scanner)
+	if !ok {
+		k.errorf("sql: the type implements driver.Valuer, but not sql.Scanner")
+		return
+	}
+
+	v, err := valuer.Value()
+	if err != nil {
+		k.errorf("sql: %q cannot be converted to a driver value: %v", k.text(value), err)
+		return
+	}
+	if err := scanner.Scan(v); err != nil {
+		k.errorf("sql: driver value %#v cannot be scanned: %v", v, err)
+		return
+	}
+	k.compare("sql", value, back)
+}
+
+func (k *checker[T, PT]) checkBSON(value T) {
+	marshaler, ok := any(value).(bsonMarshaler)
+	if !ok {
+		return
+	}
+	var back T
+	unmarshaler, ok := any(PT(&back)).(bsonUnmarshaler)
+	if !ok {
+		k.errorf("bson: the type implements MarshalBSON, but not UnmarshalBSON")
+		return
+	}
+
+	for name, codec := range codecs() {
+		func() {
+			active := bsonlite.C
+			defer bsonlite.Replace(active)
+			bsonlite.Replace(codec)
+
+			var zero T
+			back = zero
+			doc, err := marshaler.MarshalBSON()
+			if err != nil {
+				k.errorf("bson (%s codec): %q cannot be marshaled: %v", name, k.text(value), err)
+				return
+			}
+			if err := unmarshaler.UnmarshalBSON(doc); err != nil {
+				k.errorf("bson (%s codec): %q cannot be unmarshaled: %v", name, k.text(value), err)
+				return
+			}
+			k.compare(fmt.Sprintf("bson (%s codec)", name), value, back)
+		}()
+	}
+}
+
+// codecs returns the BSON codecs to check: the minimal one, and the active one when it has been replaced.
+func codecs() map[string]bsonlite.Codec {
+	lite := bsonlite.Lite()
+	if bsonlite.C == lite {
+		return map[string]bsonlite.Codec{"lite": lite}
+	}
+
+	return map[string]bsonlite.Codec{"lite": lite, "active": bsonlite.C}
+}
+
+func (k *checker[T, PT]) checkGob(value T) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		k.errorf("gob: %q cannot be encoded: %v", k.text(value), err)
+		return
+	}
+
+	var back T
+	if err := gob.NewDecoder(&buf).Decode(&back); err != nil {
+		k.errorf("gob: %q cannot be decoded: %v", k.text(value), err)
+		return
+	}
+	k.compare("gob", value, back)
+}
+
+func (k *checker[T, PT]) checkBinary(value T) {
+	marshaler, ok := any(value).(encoding.BinaryMarshaler)
+	if !ok {
+		return
+	}
+	var back T
+	unmarshaler, ok := any(PT(&back)).(encoding.BinaryUnmarshaler)
+	if !ok {
+		k.errorf("binary: the type implements encoding.BinaryMarshaler, but not encoding.BinaryUnmarshaler")
+		return
+	}
+
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		k.errorf("binary: %q cannot be marshaled: %v", k.text(value), err)
+		return
+	}
+	if err := unmarshaler.UnmarshalBinary(data); err != nil {
+		k.errorf("binary: %q cannot be unmarshaled: %v", k.text(value), err)
+		return
+	}
+	k.compare("binary", value, back)
+}
+
+func (k *checker[T, PT]) checkDeepCopy(value T) {
+	copier, ok := any(PT(&value)).(interface{ DeepCopy() PT })
+	if !ok {
+		return
+	}
+
+	copied := copier.DeepCopy()
+	switch {
+	case copied == nil:
+		k.errorf("deepcopy: %q is copied as nil", k.text(value))
+	case copied == PT(&value):
+		k.errorf("deepcopy: %q is not copied", k.text(value))
+	default:
+		k.compare("deepcopy", value, *copied)
+	}
+}
+
+func (k *checker[T, PT]) checkParse(sample string, value T) {
+	parsed, err := k.Registry.Parse(k.Name, sample)
+	if err != nil {
+		k.errorf("registry: valid %q cannot be parsed: %v", sample, err)
+		return
+	}
+
+	switch back := parsed.(type) {
+	case T:
+		k.compare("registry", value, back)
+	case PT:
+		k.compare("registry", value, *back)
+	default:
+		k.errorf("registry: %q is parsed as %T, not as %v", sample, parsed, reflect.TypeFor[T]())
+	}
+}
+
+func (k *checker[T, PT]) checkMapStructure(sample string, value T) {
+	var target struct{ Value T }
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: k.Registry.MapStructureHookFunc(),
+		Result:     &target,
+	})
+	if err != nil {
+		k.errorf("mapstructure: %v", err)
+		return
+	}
+
+	if err := decoder.Decode(map[string]any{"value": sample}); err != nil {
+		k.errorf("mapstructure: valid %q cannot be decoded: %v", sample, err)
+		return
+	}
+	k.compare("mapstructure", value, target.Value)
+}
+
+// compare reports an asymmetry when a value does not come back the same after a round trip.
+func (k *checker[T, PT]) compare(encoding string, value, back T) {
+	if k.same(value, back) {
+		return
+	}
+	if k.text(value) == k.text(back) {
+		k.errorf("%s: %#v comes back as %#v", encoding, value, back)
+		return
+	}
+	k.errorf("%s: %q comes back as %q", encoding, k.text(value), k.text(back))
+}
+
+// same checks that two values are equal and have the same text.
+func (k *checker[T, PT]) same(a, b T) bool {
+	if k.text(a) != k.text(b) {
+		return false
+	}
+	if eq, ok := any(a).(interface{ Equal(T) bool }); ok {
+		return eq.Equal(b)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func (k *checker[T, PT]) text(value T) string {
+	text, err := PT(&value).MarshalText()
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+
+	return string(text)
+}
+
+func isZero[T any](value T) bool {
+	if zero, ok := any(value).(zeroer); ok {
+		return zero.IsZero()
+	}
+
+	return reflect.ValueOf(&value).Elem().IsZero()
+}
@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmttest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/strfmttest"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestBuiltIn(t *testing.T) {
+	t.Run("uri", func(t *testing.T) {
+		strfmttest.Run[strfmt.URI](t, strfmttest.Case{
+			Name:    "uri",
+			Valid:   []string{"http://www.example.com/path?q=1", "urn:isbn:0451450523"},
+			Invalid: []string{"not a uri", "http://[::1"},
+		})
+	})
+	t.Run("email", func(t *testing.T) {
+		strfmttest.Run[strfmt.Email](t, strfmttest.Case{
+			Name:    "email",
+			Valid:   []string{"somebody@example.com"},
+			Invalid: []string{"somebody", "@example.com"},
+		})
+	})
+	t.Run("hostname", func(t *testing.T) {
+		strfmttest.Run[strfmt.Hostname](t, strfmttest.Case{
+			Name:    "hostname",
+			Valid:   []string{"example.com", "localhost"},
+			Invalid: []string{"-example.com", "exa mple.com"},
+		})
+	})
+	t.Run("ipv4", func(t *testing.T) {
+		strfmttest.Run[strfmt.IPv4](t, strfmttest.Case{
+			Name:    "ipv4",
+			Valid:   []string{"192.168.0.1"},
+			Invalid: []string{"192.168.0.256", "::1"},
+		})
+	})
+	t.Run("ipv6", func(t *testing.T) {
+		strfmttest.Run[strfmt.IPv6](t, strfmttest.Case{
+			Name:    "ipv6",
+			Valid:   []string{"::1", "2001:db8::68"},
+			Invalid: []string{"192.168.0.1", "2001:db8::g"},
+		})
+	})
+	t.Run("cidr", func(t *testing.T) {
+		strfmttest.Run[strfmt.CIDR](t, strfmttest.Case{
+			Name:    "cidr",
+			Valid:   []string{"192.0.2.0/24", "2001:db8::/32"},
+			Invalid: []string{"192.0.2.0", "192.0.2.0/33"},
+		})
+	})
+	t.Run("mac", func(t *testing.T) {
+		strfmttest.Run[strfmt.MAC](t, strfmttest.Case{
+			Name:    "mac",
+			Valid:   []string{"01:02:03:04:05:06"},
+			Invalid: []string{"01:02:03:04:05", "01:02:03:04:05:0g"},
+		})
+	})
+	t.Run("uuid", func(t *testing.T) {
+		strfmttest.Run[strfmt.UUID](t, strfmttest.Case{
+			Name:    "uuid",
+			Valid:   []string{"a8098c1a-f86e-11da-bd1a-00112444be1e"},
+			Invalid: []string{"a8098c1a-f86e-11da-bd1a", "not-a-uuid"},
+		})
+	})
+	t.Run("uuid4", func(t *testing.T) {
+		strfmttest.Run[strfmt.UUID4](t, strfmttest.Case{
+			Name:    "uuid4",
+			Valid:   []string{"025b0d74-00a2-4048-bf57-227c5111bb34"},
+			Invalid: []string{"a8098c1a-f86e-11da-bd1a-00112444be1e"},
+		})
+	})
+	t.Run("uuid7", func(t *testing.T) {
+		strfmttest.Run[strfmt.UUID7](t, strfmttest.Case{
+			Name:    "uuid7",
+			Valid:   []string{"019a15e6-cd5e-7204-b11b-12075f4c8a25"},
+			Invalid: []string{"025b0d74-00a2-4048-bf57-227c5111bb34"},
+		})
+	})
+	t.Run("isbn", func(t *testing.T) {
+		strfmttest.Run[strfmt.ISBN](t, strfmttest.Case{
+			Name:    "isbn",
+			Valid:   []string{"0321751043", "978-0321751041"},
+			Invalid: []string{"0321751044", "978"},
+		})
+	})
+	t.Run("creditcard", func(t *testing.T) {
+		strfmttest.Run[strfmt.CreditCard](t, strfmttest.Case{
+			Name:    "creditcard",
+			Valid:   []string{"4111-1111-1111-1111"},
+			Invalid: []string{"4111-1111-1111-1112"},
+		})
+	})
+	t.Run("ssn", func(t *testing.T) {
+		strfmttest.Run[strfmt.SSN](t, strfmttest.Case{
+			Name:    "ssn",
+			Valid:   []string{"111-11-1111"},
+			Invalid: []string{"111-11-111"},
+		})
+	})
+	t.Run("hexcolor", func(t *testing.T) {
+		strfmttest.Run[strfmt.HexColor](t, strfmttest.Case{
+			Name:    "hexcolor",
+			Valid:   []string{"#FFFFFF", "#abc"},
+			Invalid: []string{"#ggg", "#abcd"},
+		})
+	})
+	t.Run("rgbcolor", func(t *testing.T) {
+		strfmttest.Run[strfmt.RGBColor](t, strfmttest.Case{
+			Name:    "rgbcolor",
+			Valid:   []string{"rgb(255,255,255)"},
+			Invalid: []string{"rgb(256,255,255)", "#FFFFFF"},
+		})
+	})
+	t.Run("password", func(t *testing.T) {
+		strfmttest.Run[strfmt.Password](t, strfmttest.Case{
+			Name:  "password",
+			Valid: []string{"super secret stuff here"},
+		})
+	})
+	t.Run("timezone", func(t *testing.T) {
+		strfmttest.Run[strfmt.TimeZone](t, strfmttest.Case{
+			Name:    "timezone",
+			Valid:   []string{"Europe/Paris", "UTC"},
+			Invalid: []string{"Europe/Nowhere"},
+		})
+	})
+	t.Run("cron", func(t *testing.T) {
+		strfmttest.Run[strfmt.Cron](t, strfmttest.Case{
+			Name:    "cron",
+			Valid:   []string{"*/15 * * * *"},
+			Invalid: []string{"* * *", "61 * * * *"},
+		})
+	})
+	t.Run("date", func(t *testing.T) {
+		strfmttest.Run[strfmt.Date](t, strfmttest.Case{
+			Name:    "date",
+			Valid:   []string{"2014-12-15", "2024-02-29"},
+			Invalid: []string{"2023-02-29", "15/12/2014"},
+		})
+	})
+	t.Run("datetime", func(t *testing.T) {
+		strfmttest.Run[strfmt.DateTime](t, strfmttest.Case{
+			Name:    "datetime",
+			Valid:   []string{"2012-03-02T15:06:05.999Z", "2012-03-02T15:06:05Z"},
+			Invalid: []string{"2012-03-02 15:06", "not a date"},
+		})
+	})
+	t.Run("duration", func(t *testing.T) {
+		strfmttest.Run[strfmt.Duration](t, strfmttest.Case{
+			Name:    "duration",
+			Valid:   []string{"5s", "3h"},
+			Invalid: []string{"five seconds"},
+		})
+	})
+	t.Run("ulid", func(t *testing.T) {
+		strfmttest.Run[strfmt.ULID](t, strfmttest.Case{
+			Name:    "ulid",
+			Valid:   []string{"01EYXZVGBHG26MFTG4JWR4K558", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+			Invalid: []string{"01EYXZVGBHG26MFTG4JWR4K55", "8ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		})
+	})
+	t.Run("objectid", func(t *testing.T) {
+		strfmttest.Run[strfmt.ObjectId](t, strfmttest.Case{
+			Name:    "bsonobjectid",
+			Valid:   []string{"507f1f77bcf86cd799439011"},
+			Invalid: []string{"507f1f77bcf86cd79943901", "not an objectid"},
+		})
+	})
+	t.Run("interval", func(t *testing.T) {
+		strfmttest.Run[strfmt.Interval](t, strfmttest.Case{
+			Name:    "interval",
+			Valid:   []string{"2024-01-01/P1M"},
+			Invalid: []string{"2024-01-01"},
+		})
+	})
+	t.Run("repeatinginterval", func(t *testing.T) {
+		strfmttest.Run[strfmt.RepeatingInterval](t, strfmttest.Case{
+			Name:    "repeatinginterval",
+			Valid:   []string{"R2/2024-01-01/P1D"},
+			Invalid: []string{"2024-01-01/P1D"},
+		})
+	})
+	t.Run("yearmonth", func(t *testing.T) {
+		strfmttest.Run[strfmt.YearMonth](t, strfmttest.Case{
+			Name:    "yearmonth",
+			Valid:   []string{"2024-05"},
+			Invalid: []string{"2024-13"},
+		})
+	})
+	t.Run("year", func(t *testing.T) {
+		strfmttest.Run[strfmt.Year](t, strfmttest.Case{
+			Name:    "year",
+			Valid:   []string{"2024", "0001"},
+			Invalid: []string{"24", "year"},
+		})
+	})
+	t.Run("zoneddatetime", func(t *testing.T) {
+		strfmttest.Run[strfmt.ZonedDateTime](t, strfmttest.Case{
+			Name:    "zoneddatetime",
+			Valid:   []string{"2024-05-01T10:00:00+02:00[Europe/Paris]"},
+			Invalid: []string{"2024-05-01 10:00"},
+		})
+	})
+	t.Run("httpdate", func(t *testing.T) {
+		strfmttest.Run[strfmt.HTTPDate](t, strfmttest.Case{
+			Name:    "httpdate",
+			Valid:   []string{"Sun, 06 Nov 1994 08:49:37 GMT"},
+			Invalid: []string{"1994-11-06T08:49:37Z"},
+		})
+	})
+	t.Run("rrule", func(t *testing.T) {
+		strfmttest.Run[strfmt.RRule](t, strfmttest.Case{
+			Name:    "rrule",
+			Valid:   []string{"FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10"},
+			Invalid: []string{"FREQ=SOMETIMES"},
+		})
+	})
+}
+
+func TestBuiltIn_Base64(t *testing.T) {
+	errs := strfmttest.Check[strfmt.Base64](strfmttest.Case{
+		Name:    "byte",
+		Valid:   []string{"ZWxpemFiZXRocG9zZXk="},
+		Invalid: []string{"not base64!"},
+	})
+
+	// known asymmetry: the mapstructure hook keeps the encoded text as bytes
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "mapstructure:")
+}
+
+type zipCode struct{}
+
+func (zipCode) FormatName() string { return "zipcode" }
+
+func (zipCode) IsValid(s string) bool {
+	return len(s) == 5 && strings.Trim(s, "0123456789") == ""
+}
+
+type ZipCode = strfmt.StringFormat[zipCode]
+
+func TestStringFormat(t *testing.T) {
+	registry := strfmt.NewFormats()
+	strfmt.AddStringFormat[zipCode](registry)
+
+	strfmttest.Run[ZipCode](t, strfmttest.Case{
+		Name:     "zipcode",
+		Registry: registry,
+		Valid:    []string{"12345", "00000"},
+		Invalid:  []string{"1234", "abcde"},
+	})
+
+	errs := strfmttest.Check[ZipCode](strfmttest.Case{Name: "zipcode", Valid: []string{"12345"}})
+	require.Len(t, errs, 1, "the format is not registered in strfmt.Default")
+	assert.ErrorContains(t, errs[0], `format "zipcode" is not registered`)
+}
+
+// lossyFormat loses the case of its value when marshaled.
+type lossyFormat string
+
+func (l lossyFormat) String() string { return string(l) }
+
+func (l lossyFormat) MarshalText() ([]byte, error) { return []byte(strings.ToLower(string(l))), nil }
+
+func (l *lossyFormat) UnmarshalText(data []byte) error {
+	*l = lossyFormat(data)
+	return nil
+}
+
+func TestCheck(t *testing.T) {
+	errs := strfmttest.Check[lossyFormat](strfmttest.Case{
+		Valid:   []string{"ABC"},
+		Invalid: []string{"123"},
+	})
+
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	assert.Equal(t, []string{
+		`text: "ABC" comes back as "abc"`,
+		`json: "ABC" comes back as "abc"`,
+		`text: invalid "123" is accepted, and the type has no Validate method`,
+	}, messages)
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// StringValidator describes a format based on a string, for [StringFormat].
+//
+// Implementations are usually empty structs, e.g.
+//
+//	type zipCode struct{}
+//
+//	func (zipCode) FormatName() string { return "zipcode" }
+//	func (zipCode) IsValid(s string) bool { return len(s) == 5 }
+//
+//	type ZipCode = strfmt.StringFormat[zipCode]
+type StringValidator interface {
+	// FormatName returns the name of the format, e.g. "email".
+	FormatName() string
+
+	// IsValid checks if a string is a valid value of the format, like a [Validator].
+	IsValid(string) bool
+}
+
+// StringCanonicalizer may be implemented by a [StringValidator] to provide the canonical form
+// of valid values (see [StringFormat.Canonical]).
+type StringCanonicalizer interface {
+	Canonical(string) (string, error)
+}
+
+// sensitiveFormat is implemented by the descriptions of formats whose values must not appear in errors.
+type sensitiveFormat interface {
+	sensitive()
+}
+
+// StringFormat is a building block for formats based on a string.
+//
+// A [StringFormat] supports text, JSON, SQL and BSON encodings, deep copies, validation, canonical forms
+// and equality, along its [StringValidator]:
+//
+//   - like other formats, any string is accepted when unmarshaled, unless [ValidateOnUnmarshal] is enabled
+//   - values are validated by [StringValidator.IsValid]
+//   - canonical forms are provided by the [StringValidator] when it implements [StringCanonicalizer]
+//
+// Use [AddStringFormat] to register it.
+//
+// The formats of this package which are based on a string, e.g. [Email], are implemented on top of [StringFormat].
+type StringFormat[V StringValidator] string
+
+// AddStringFormat registers a [StringFormat] in a registry, under the name of its format.
+//
+// It returns true if this was a new format instead of a replacement.
+func AddStringFormat[V StringValidator](registry Registry) bool {
+	var (
+		spec  V
+		value StringFormat[V]
+	)
+
+	return registry.Add(spec.FormatName(), &value, spec.IsValid)
+}
+
+func (s StringFormat[V]) String() string {
+	return string(s)
+}
+
+// MarshalText turns this instance into text.
+func (s StringFormat[V]) MarshalText() ([]byte, error) {
+	return []byte(string(s)), nil
+}
+
+// UnmarshalText hydrates this instance from text.
+func (s *StringFormat[V]) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return unmarshaled(s, StringFormat[V](data))
+}
+
+// Scan read a value from a database driver.
+func (s *StringFormat[V]) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return unmarshaled(s, StringFormat[V](v))
+	case string:
+		return unmarshaled(s, StringFormat[V](v))
+	case nil:
+		*s = ""
+		return nil
+	default:
+		var spec V
+		return fmt.Errorf("cannot sql.Scan() %s format from: %#v: %w", spec.FormatName(), v, ErrFormat)
+	}
+}
+
+// Value converts a value to a database driver value.
+func (s StringFormat[V]) Value() (driver.Value, error) {
+	return driver.Value(string(s)), nil
+}
+
+// MarshalJSON returns the value as JSON.
+func (s StringFormat[V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+// UnmarshalJSON sets the value from JSON.
+func (s *StringFormat[V]) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return unmarshaled(s, StringFormat[V](str))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (s *StringFormat[V]) DeepCopyInto(out *StringFormat[V]) {
+	*out = *s
+}
+
+// DeepCopy copies the receiver into a new value.
+func (s *StringFormat[V]) DeepCopy() *StringFormat[V] {
+	if s == nil {
+		return nil
+	}
+	out := new(StringFormat[V])
+	s.DeepCopyInto(out)
+	return out
+}
+
+// Validate checks that the value is valid for its format.
+func (s StringFormat[V]) Validate() error {
+	var spec V
+	if spec.IsValid(string(s)) {
+		return nil
+	}
+	if _, ok := any(spec).(sensitiveFormat); ok {
+		return &ValidationError{Format: spec.FormatName()}
+	}
+
+	return &ValidationError{Format: spec.FormatName(), Value: string(s)}
+}
+
+// Canonical returns the canonical form of a valid value, as provided by a [StringCanonicalizer].
+// Otherwise, valid values are returned unchanged.
+func (s StringFormat[V]) Canonical() (StringFormat[V], error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+	var spec V
+	canonicalizer, ok := any(spec).(StringCanonicalizer)
+	if !ok {
+		return s, nil
+	}
+	canonical, err := canonicalizer.Canonical(string(s))
+	if err != nil {
+		return "", err
+	}
+
+	return StringFormat[V](canonical), nil
+}
+
+// canonicalText supports [Registry.Canonicalize].
+func (s StringFormat[V]) canonicalText() (string, error) {
+	canonical, err := s.Canonical()
+
+	return string(canonical), err
+}
+
+// Equal checks if two values have the same canonical form. Invalid values are only equal to the very same string.
+func (s StringFormat[V]) Equal(other StringFormat[V]) bool {
+	return equalCanonical(s, other)
+}
+
+// IsZero returns true when the value is empty.
+func (s StringFormat[V]) IsZero() bool {
+	return s == ""
+}
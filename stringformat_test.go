@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+type countryCode struct{}
+
+func (countryCode) FormatName() string { return "countrycode" }
+
+func (countryCode) IsValid(s string) bool {
+	return len(s) == 2 && strings.IndexFunc(s, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z')
+	}) < 0
+}
+
+func (countryCode) Canonical(s string) (string, error) { return strings.ToUpper(s), nil }
+
+type CountryCode = StringFormat[countryCode]
+
+type plainCode struct{}
+
+func (plainCode) FormatName() string { return "plaincode" }
+
+func (plainCode) IsValid(s string) bool { return s != "" }
+
+var (
+	_ Format          = new(CountryCode)
+	_ sql.Scanner     = new(CountryCode)
+	_ driver.Valuer   = CountryCode("")
+	_ bsonMarshaler   = CountryCode("")
+	_ bsonUnmarshaler = new(CountryCode)
+)
+
+func TestStringFormat(t *testing.T) {
+	t.Run("should encode like a string", func(t *testing.T) {
+		code := CountryCode("fr")
+
+		js, err := json.Marshal(code)
+		require.NoError(t, err)
+		assert.EqualT(t, `"fr"`, string(js))
+
+		var back CountryCode
+		require.NoError(t, json.Unmarshal(js, &back))
+		assert.EqualT(t, code, back)
+		require.NoError(t, back.UnmarshalJSON([]byte(jsonNull)))
+		assert.EqualT(t, code, back)
+
+		v, err := code.Value()
+		require.NoError(t, err)
+		assert.EqualValues(t, "fr", v)
+		require.NoError(t, back.Scan([]byte("de")))
+		assert.EqualT(t, CountryCode("de"), back)
+		require.NoError(t, back.Scan(nil))
+		assert.TrueT(t, back.IsZero())
+		require.ErrorIs(t, back.Scan(42), ErrFormat)
+
+		doc, err := code.MarshalBSON()
+		require.NoError(t, err)
+		require.NoError(t, back.UnmarshalBSON(doc))
+		assert.EqualT(t, code, back)
+
+		assert.EqualT(t, code, *code.DeepCopy())
+		assert.Nil(t, (*CountryCode)(nil).DeepCopy())
+	})
+
+	t.Run("should validate", func(t *testing.T) {
+		require.NoError(t, CountryCode("fr").Validate())
+
+		err := CountryCode("fra").Validate()
+		require.ErrorIs(t, err, ErrFormat)
+		var verr *ValidationError
+		require.TrueT(t, errors.As(err, &verr))
+		assert.EqualT(t, ValidationError{Format: "countrycode", Value: "fra"}, *verr)
+
+		var code CountryCode
+		require.NoError(t, code.UnmarshalText([]byte("fra")), "values are validated later on by default")
+
+		withValidateOnUnmarshal(t)
+		require.ErrorIs(t, code.UnmarshalText([]byte("f1")), ErrFormat)
+		require.ErrorIs(t, json.Unmarshal([]byte(`"f1"`), &code), ErrFormat)
+		assert.EqualT(t, CountryCode("fra"), code)
+	})
+
+	t.Run("should canonicalize and compare", func(t *testing.T) {
+		canonical, err := CountryCode("fr").Canonical()
+		require.NoError(t, err)
+		assert.EqualT(t, CountryCode("FR"), canonical)
+		assert.TrueT(t, CountryCode("fr").Equal("FR"))
+		assert.FalseT(t, CountryCode("fr").Equal("de"))
+
+		_, err = CountryCode("f").Canonical()
+		require.ErrorIs(t, err, ErrFormat)
+
+		plain, err := StringFormat[plainCode]("Any").Canonical()
+		require.NoError(t, err)
+		assert.EqualT(t, StringFormat[plainCode]("Any"), plain)
+	})
+
+	t.Run("should register in a registry", func(t *testing.T) {
+		registry := NewFormats()
+		assert.TrueT(t, AddStringFormat[countryCode](registry))
+		assert.FalseT(t, AddStringFormat[countryCode](registry))
+
+		assert.TrueT(t, registry.Validates("countrycode", "fr"))
+		assert.FalseT(t, registry.Validates("countrycode", "fra"))
+
+		v, err := registry.Parse("countrycode", "fr")
+		require.NoError(t, err)
+		require.IsType(t, new(CountryCode), v)
+
+		canonical, err := registry.Canonicalize("countrycode", "fr")
+		require.NoError(t, err)
+		assert.EqualT(t, "FR", canonical)
+
+		var decoded struct{ Code CountryCode }
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook: registry.MapStructureHookFunc(),
+			Result:     &decoded,
+		})
+		require.NoError(t, err)
+		require.NoError(t, decoder.Decode(map[string]any{"code": "fr"}))
+		assert.EqualT(t, CountryCode("fr"), decoded.Code)
+	})
+}
+
+func TestStringFormat_BuiltIn(t *testing.T) {
+	var hexColor HexColor
+	require.NoError(t, hexColor.Scan("#abc"))
+	assert.EqualT(t, HexColor("#abc"), hexColor)
+	require.ErrorIs(t, hexColor.Scan(1), ErrFormat)
+
+	doc, err := HexColor("#abc").MarshalBSON()
+	require.NoError(t, err)
+	var back StringFormat[hexColorFormat]
+	require.NoError(t, back.UnmarshalBSON(doc))
+	assert.EqualT(t, "#abc", back.String())
+}
@@ -5,7 +5,6 @@ package strfmt
 
 import (
 	"database/sql/driver"
-	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -98,20 +97,16 @@ var (
 )
 
 // ParseDateTime parses a string that represents an ISO8601 time or a unix epoch.
+//
+// The layouts listed in [DateTimeFormats] are recognized in a single pass. Other layouts added to [DateTimeFormats]
+// are tried next. When the string cannot be parsed, the error is a [*DateTimeParseError] which locates the first
+// unexpected character.
+//
+// Unix epoch timestamps such as "1700000000" or "1700000000.5" are only accepted when [ParseEpochUnit] is set.
+//
+// See [TimeConfig.ParseDateTime] to parse with other settings than the package-level ones.
 func ParseDateTime(data string) (DateTime, error) {
-	if data == "" {
-		return NewDateTime(), nil
-	}
-	var lastError error
-	for _, layout := range DateTimeFormats {
-		dd, err := time.ParseInLocation(layout, data, DefaultTimeLocation)
-		if err != nil {
-			lastError = err
-			continue
-		}
-		return DateTime(dd), nil
-	}
-	return DateTime{}, lastError
+	return TimeConfig{}.ParseDateTime(data)
 }
 
 // DateTime is a time but it serializes to ISO8601 format with millis.
@@ -141,7 +136,7 @@ func MakeDateTime() DateTime {
 
 // String converts this time to a string.
 func (t DateTime) String() string {
-	return NormalizeTimeForMarshal(time.Time(t)).Format(MarshalFormat)
+	return TimeConfig{}.FormatDateTime(t)
 }
 
 // IsZero returns whether the date time is a zero value.
@@ -171,7 +166,6 @@ func (t *DateTime) UnmarshalText(text []byte) error {
 
 // Scan scans a [DateTime] value from database driver type.
 func (t *DateTime) Scan(raw any) error {
-	// Proposal for enhancement: case int64: and case float64: ?
 	switch v := raw.(type) {
 	case []byte:
 		return t.UnmarshalText(v)
@@ -179,6 +173,18 @@ func (t *DateTime) Scan(raw any) error {
 		return t.UnmarshalText([]byte(v))
 	case time.Time:
 		*t = DateTime(v)
+	case int64:
+		tt, err := epochFromInt(v, ParseEpochUnit, DefaultTimeLocation)
+		if err != nil {
+			return fmt.Errorf("cannot sql.Scan() strfmt.DateTime from: %#v: %w", v, err)
+		}
+		*t = DateTime(tt)
+	case float64:
+		tt, err := epochFromFloat(v, ParseEpochUnit, DefaultTimeLocation)
+		if err != nil {
+			return fmt.Errorf("cannot sql.Scan() strfmt.DateTime from: %#v: %w", v, err)
+		}
+		*t = DateTime(tt)
 	case nil:
 		*t = DateTime{}
 	default:
@@ -189,13 +195,20 @@ func (t *DateTime) Scan(raw any) error {
 }
 
 // Value converts [DateTime] to a primitive value ready to written to a database.
+//
+// When [MarshalEpochUnit] is set, the value is an int64 unix epoch timestamp.
 func (t DateTime) Value() (driver.Value, error) {
+	if MarshalEpochUnit.enabled() {
+		return driver.Value(epochOf(time.Time(t), MarshalEpochUnit)), nil
+	}
 	return driver.Value(t.String()), nil
 }
 
 // MarshalJSON returns the [DateTime] as JSON.
+//
+// When [MarshalEpochUnit] is set, the [DateTime] is rendered as a JSON number.
 func (t DateTime) MarshalJSON() ([]byte, error) {
-	return json.Marshal(NormalizeTimeForMarshal(time.Time(t)).Format(MarshalFormat))
+	return TimeConfig{}.MarshalDateTimeJSON(t)
 }
 
 // UnmarshalJSON sets the [DateTime] from JSON.
@@ -204,11 +217,7 @@ func (t *DateTime) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	var tstr string
-	if err := json.Unmarshal(data, &tstr); err != nil {
-		return err
-	}
-	tt, err := ParseDateTime(tstr)
+	tt, err := TimeConfig{}.UnmarshalDateTimeJSON(data)
 	if err != nil {
 		return err
 	}
@@ -258,11 +258,15 @@ func TestDateTime_Scan_Failed(t *testing.T) {
 	require.NoError(t, err)
 	assert.EqualT(t, zero, pp)
 
+	// unix epoch timestamps are disabled by default
 	err = pp.Scan(int64(0))
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrFormat)
 
 	err = pp.Scan(float64(0))
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrFormat)
+
+	err = pp.Scan(true)
+	require.ErrorIs(t, err, ErrFormat)
 }
 
 func TestDeepCopyDateTime(t *testing.T) {
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// TimeConfig holds the settings used to parse and marshal [DateTime] and [Date] values.
+//
+// A zero field stands for the corresponding package-level default: [DateTimeFormats], [DateFormats],
+// [MarshalFormat], [NormalizeTimeForMarshal], [DefaultTimeLocation], [ParseEpochUnit] and [MarshalEpochUnit].
+// Hence, the zero [TimeConfig] follows the package-level settings.
+//
+// A [TimeConfig] may be attached to a registry (see [WithTimeConfig]), so that several components
+// in the same program may use different settings without altering the package-level ones.
+//
+// The methods of [DateTime] and [Date] (e.g. MarshalJSON) have no such registry: they follow the package-level settings.
+// Use the methods of [TimeConfig] to parse or marshal with specific settings.
+type TimeConfig struct {
+	// DateTimeFormats is the collection of layouts used to parse a [DateTime].
+	DateTimeFormats []string
+
+	// DateFormats is the collection of layouts used to parse a [Date].
+	DateFormats []string
+
+	// MarshalFormat is the layout used to marshal a [DateTime].
+	MarshalFormat string
+
+	// NormalizeTimeForMarshal is applied to a [DateTime] before marshaling.
+	NormalizeTimeForMarshal func(time.Time) time.Time
+
+	// DefaultTimeLocation is the location of times parsed without a time zone.
+	DefaultTimeLocation *time.Location
+
+	// ParseEpochUnit is the unit of unix epoch timestamps accepted when parsing a [DateTime].
+	// Unlike the zero [EpochDefault], [EpochDisabled] rejects unix epoch timestamps whatever the package-level setting.
+	ParseEpochUnit EpochUnit
+
+	// MarshalEpochUnit is the unit of unix epoch timestamps used when marshaling a [DateTime].
+	// Unlike the zero [EpochDefault], [EpochDisabled] marshals strings whatever the package-level setting.
+	MarshalEpochUnit EpochUnit
+}
+
+// DefaultTimeConfig returns a [TimeConfig] holding the current package-level settings.
+func DefaultTimeConfig() TimeConfig {
+	return TimeConfig{}.resolved()
+}
+
+// resolved fills the zero fields with the package-level settings.
+func (c TimeConfig) resolved() TimeConfig {
+	if c.DateTimeFormats == nil {
+		c.DateTimeFormats = DateTimeFormats
+	}
+	if c.DateFormats == nil {
+		c.DateFormats = DateFormats
+	}
+	if c.MarshalFormat == "" {
+		c.MarshalFormat = MarshalFormat
+	}
+	if c.NormalizeTimeForMarshal == nil {
+		c.NormalizeTimeForMarshal = NormalizeTimeForMarshal
+	}
+	if c.DefaultTimeLocation == nil {
+		c.DefaultTimeLocation = DefaultTimeLocation
+	}
+	if c.ParseEpochUnit == EpochDefault {
+		c.ParseEpochUnit = ParseEpochUnit
+	}
+	if c.MarshalEpochUnit == EpochDefault {
+		c.MarshalEpochUnit = MarshalEpochUnit
+	}
+
+	return c
+}
+
+// ParseDateTime parses a string that represents an ISO8601 time or a unix epoch, like [ParseDateTime] does with this configuration.
+func (c TimeConfig) ParseDateTime(data string) (DateTime, error) {
+	if data == "" {
+		return NewDateTime(), nil
+	}
+
+	c = c.resolved()
+	if c.ParseEpochUnit.enabled() && isEpoch(data) {
+		tt, err := parseEpoch(data, c.ParseEpochUnit, c.DefaultTimeLocation)
+		if err != nil {
+			return DateTime{}, err
+		}
+		return DateTime(tt), nil
+	}
+
+	dd, err := parseDateTimeLayouts(data, c.DateTimeFormats, c.DefaultTimeLocation)
+	if err != nil {
+		return DateTime{}, err
+	}
+	return DateTime(dd), nil
+}
+
+// ParseDate parses a string that represents a date, like [ParseDate] does with this configuration.
+func (c TimeConfig) ParseDate(data string) (Date, error) {
+	c = c.resolved()
+
+	return parseDateLayouts(data, c.DateFormats, c.DefaultTimeLocation)
+}
+
+// FormatDateTime renders a [DateTime] as a string, like [DateTime.String] does with this configuration.
+func (c TimeConfig) FormatDateTime(t DateTime) string {
+	c = c.resolved()
+
+	return c.NormalizeTimeForMarshal(time.Time(t)).Format(c.MarshalFormat)
+}
+
+// MarshalDateTimeJSON renders a [DateTime] as JSON, like [DateTime.MarshalJSON] does with this configuration.
+func (c TimeConfig) MarshalDateTimeJSON(t DateTime) ([]byte, error) {
+	c = c.resolved()
+	if c.MarshalEpochUnit.enabled() {
+		return strconv.AppendInt(nil, epochOf(time.Time(t), c.MarshalEpochUnit), 10), nil
+	}
+
+	return json.Marshal(c.NormalizeTimeForMarshal(time.Time(t)).Format(c.MarshalFormat))
+}
+
+// UnmarshalDateTimeJSON reads a [DateTime] from JSON, like [DateTime.UnmarshalJSON] does with this configuration.
+//
+// A JSON null yields a zero [DateTime].
+func (c TimeConfig) UnmarshalDateTimeJSON(data []byte) (DateTime, error) {
+	if string(data) == jsonNull {
+		return DateTime{}, nil
+	}
+
+	c = c.resolved()
+	if len(data) > 0 && data[0] != '"' {
+		// JSON number: a unix epoch timestamp
+		var num json.Number
+		if err := json.Unmarshal(data, &num); err != nil {
+			return DateTime{}, err
+		}
+		tt, err := parseEpoch(num.String(), c.ParseEpochUnit, c.DefaultTimeLocation)
+		if err != nil {
+			return DateTime{}, err
+		}
+		return DateTime(tt), nil
+	}
+
+	var tstr string
+	if err := json.Unmarshal(data, &tstr); err != nil {
+		return DateTime{}, err
+	}
+
+	return c.ParseDateTime(tstr)
+}
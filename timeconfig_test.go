@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+func TestTimeConfig_Zero(t *testing.T) {
+	var c TimeConfig
+
+	dt, err := c.ParseDateTime("2024-05-01T10:00:00Z")
+	require.NoError(t, err)
+	expected, err := ParseDateTime("2024-05-01T10:00:00Z")
+	require.NoError(t, err)
+	assert.EqualT(t, expected.String(), c.FormatDateTime(dt))
+
+	d, err := c.ParseDate("2024-05-01")
+	require.NoError(t, err)
+	assert.EqualT(t, "2024-05-01", d.String())
+
+	resolved := DefaultTimeConfig()
+	assert.EqualT(t, MarshalFormat, resolved.MarshalFormat)
+	assert.EqualT(t, DefaultTimeLocation, resolved.DefaultTimeLocation)
+	assert.EqualT(t, ParseEpochUnit, resolved.ParseEpochUnit)
+}
+
+func TestTimeConfig_Custom(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	c := TimeConfig{
+		DateTimeFormats:     []string{"02/01/2006 15:04"},
+		MarshalFormat:       time.RFC1123Z,
+		DefaultTimeLocation: paris,
+		ParseEpochUnit:      EpochMillis,
+		MarshalEpochUnit:    EpochSeconds,
+	}
+
+	t.Run("should parse with custom layouts and location", func(t *testing.T) {
+		dt, err := c.ParseDateTime("01/05/2024 10:00")
+		require.NoError(t, err)
+		assert.EqualT(t, time.Date(2024, time.May, 1, 10, 0, 0, 0, paris).Unix(), time.Time(dt).Unix())
+
+		// the builtin layouts are not enabled by the custom layouts
+		_, err = ParseDateTime("01/05/2024 10:00")
+		require.ErrorIs(t, err, ErrFormat)
+	})
+
+	t.Run("should parse epochs", func(t *testing.T) {
+		dt, err := c.ParseDateTime("1700000000123")
+		require.NoError(t, err)
+		assert.EqualT(t, int64(1700000000123), time.Time(dt).UnixMilli())
+
+		dt, err = c.UnmarshalDateTimeJSON([]byte("1700000000123"))
+		require.NoError(t, err)
+		assert.EqualT(t, int64(1700000000123), time.Time(dt).UnixMilli())
+
+		// package-level settings are unaffected
+		_, err = ParseDateTime("1700000000123")
+		require.ErrorIs(t, err, ErrFormat)
+	})
+
+	t.Run("should format and marshal", func(t *testing.T) {
+		dt := DateTime(time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC))
+		assert.EqualT(t, "Wed, 01 May 2024 10:00:00 +0000", c.FormatDateTime(dt))
+
+		b, err := c.MarshalDateTimeJSON(dt)
+		require.NoError(t, err)
+		assert.EqualT(t, "1714557600", string(b))
+
+		b, err = dt.MarshalJSON()
+		require.NoError(t, err)
+		assert.EqualT(t, `"2024-05-01T10:00:00.000Z"`, string(b))
+	})
+
+	t.Run("should unmarshal null as zero", func(t *testing.T) {
+		dt, err := c.UnmarshalDateTimeJSON([]byte(jsonNull))
+		require.NoError(t, err)
+		assert.TrueT(t, time.Time(dt).IsZero())
+	})
+}
+
+func TestTimeConfig_EpochDisabled(t *testing.T) {
+	withEpochUnits(t, EpochSeconds, EpochSeconds)
+	dt := DateTime(time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC))
+
+	t.Run("the zero unit should follow the package-level settings", func(t *testing.T) {
+		var c TimeConfig
+		_, err := c.ParseDateTime("1700000000")
+		require.NoError(t, err)
+
+		b, err := c.MarshalDateTimeJSON(dt)
+		require.NoError(t, err)
+		assert.EqualT(t, "1714557600", string(b))
+	})
+
+	t.Run("EpochDisabled should override the package-level settings", func(t *testing.T) {
+		c := TimeConfig{ParseEpochUnit: EpochDisabled, MarshalEpochUnit: EpochDisabled}
+		_, err := c.ParseDateTime("1700000000")
+		require.ErrorIs(t, err, ErrFormat)
+
+		_, err = c.UnmarshalDateTimeJSON([]byte("1700000000"))
+		require.ErrorIs(t, err, ErrFormat)
+
+		b, err := c.MarshalDateTimeJSON(dt)
+		require.NoError(t, err)
+		assert.EqualT(t, `"2024-05-01T10:00:00.000Z"`, string(b))
+	})
+}
+
+func TestTimeConfig_Registry(t *testing.T) {
+	c := TimeConfig{
+		DateTimeFormats: []string{"02/01/2006 15:04"},
+		DateFormats:     []string{"02/01/2006"},
+	}
+	registry := NewFormats(WithTimeConfig(c))
+
+	assert.EqualT(t, DateTimeFormats[0], TimeConfigOf(NewFormats()).DateTimeFormats[0])
+	assert.EqualT(t, "02/01/2006 15:04", TimeConfigOf(registry).DateTimeFormats[0])
+
+	t.Run("should parse with the registry settings", func(t *testing.T) {
+		v, err := registry.Parse("date-time", "01/05/2024 10:00")
+		require.NoError(t, err)
+		dt, ok := v.(*DateTime)
+		require.TrueT(t, ok)
+		assert.EqualT(t, 2024, time.Time(*dt).Year())
+
+		v, err = registry.Parse("date", "01/05/2024")
+		require.NoError(t, err)
+		d, ok := v.(*Date)
+		require.TrueT(t, ok)
+		assert.EqualT(t, "2024-05-01", d.String())
+
+		_, err = NewFormats().Parse("date-time", "01/05/2024 10:00")
+		require.Error(t, err)
+	})
+
+	t.Run("should validate with the registry settings", func(t *testing.T) {
+		assert.TrueT(t, registry.Validates("date-time", "01/05/2024 10:00"))
+		assert.TrueT(t, registry.Validates("date", "01/05/2024"))
+		assert.FalseT(t, registry.Validates("date-time", "2024-05-01T10:00:00Z"), "the custom layouts replace the builtin ones")
+		assert.FalseT(t, registry.Validates("date-time", ""))
+		assert.FalseT(t, registry.Validates("date", ""))
+
+		assert.FalseT(t, NewFormats().Validates("date-time", "01/05/2024 10:00"))
+
+		strict := NewStrictFormats(WithTimeConfig(c))
+		assert.FalseT(t, strict.Validates("date-time", "01/05/2024 10:00"), "a strict registry validates RFC 3339 date-times")
+		assert.TrueT(t, strict.Validates("date", "01/05/2024"))
+	})
+
+	t.Run("should decode with the registry settings", func(t *testing.T) {
+		type layout struct {
+			D  Date     `json:"d"`
+			DT DateTime `json:"dt"`
+		}
+		test := new(layout)
+		d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook: registry.MapStructureHookFunc(),
+			Result:     test,
+		})
+		require.NoError(t, err)
+		require.NoError(t, d.Decode(map[string]any{"d": "01/05/2024", "dt": "01/05/2024 10:00"}))
+		assert.EqualT(t, "2024-05-01", test.D.String())
+		assert.EqualT(t, 10, time.Time(test.DT).Hour())
+	})
+}
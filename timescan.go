@@ -0,0 +1,412 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// DateTimeParseError reports the position of the first character which prevents a string from being parsed as a [DateTime].
+//
+// It wraps [ErrFormat].
+type DateTimeParseError struct {
+	// Value is the string being parsed.
+	Value string
+	// Pos is the byte offset in Value of the first unexpected character.
+	Pos int
+	// Msg describes what was expected.
+	Msg string
+}
+
+// Error implements the error interface.
+func (e *DateTimeParseError) Error() string {
+	return fmt.Sprintf("parsing date-time %q: %s at position %d", e.Value, e.Msg, e.Pos)
+}
+
+// Unwrap returns [ErrFormat].
+func (e *DateTimeParseError) Unwrap() error {
+	return ErrFormat
+}
+
+// layoutMask is a set of built-in date-time layouts, one bit per entry in builtinDateTimeLayouts.
+type layoutMask uint16
+
+const (
+	maskRFC3339Micro layoutMask = 1 << iota
+	maskRFC3339MicroNoColon
+	maskRFC3339Millis
+	maskRFC3339MillisNoColon
+	maskRFC3339
+	maskRFC3339Nano
+	maskLocalTime
+	maskReducedPrecision
+	maskReducedPrecisionLocalTime
+	maskUniversalSortable
+	maskUniversalSortableShort
+)
+
+const (
+	millisDigits = 3
+	microsDigits = 6
+	hoursPerDay  = 24
+
+	// maxOffsetMinutes is the largest numerical offset tolerated by [time.Parse] (24:60).
+	maxOffsetMinutes = hoursPerDay*minutesPerHour + minutesPerHour
+)
+
+// builtinDateTimeLayouts lists the layouts recognized by the date-time scanner, in the order of the bits of [layoutMask].
+//
+//nolint:gochecknoglobals // read-only table
+var builtinDateTimeLayouts = [...]string{
+	RFC3339Micro,
+	RFC3339MicroNoColon,
+	RFC3339Millis,
+	RFC3339MillisNoColon,
+	time.RFC3339,
+	time.RFC3339Nano,
+	ISO8601LocalTime,
+	ISO8601TimeWithReducedPrecision,
+	ISO8601TimeWithReducedPrecisionLocaltime,
+	ISO8601TimeUniversalSortableDateTimePattern,
+	ISO8601TimeUniversalSortableDateTimePatternShortForm,
+}
+
+// fixedZones caches the locations of numerical offsets, so parsing does not allocate a new [time.Location] each time.
+//
+//nolint:gochecknoglobals // cache shared by all parsers
+var fixedZones [2*maxOffsetMinutes + 1]atomic.Pointer[time.Location]
+
+// fixedZone returns a cached unnamed location for an offset expressed in minutes.
+func fixedZone(offsetMinutes int) *time.Location {
+	slot := &fixedZones[offsetMinutes+maxOffsetMinutes]
+	if loc := slot.Load(); loc != nil {
+		return loc
+	}
+
+	loc := time.FixedZone("", offsetMinutes*secondsPerMinute)
+	if !slot.CompareAndSwap(nil, loc) {
+		return slot.Load()
+	}
+
+	return loc
+}
+
+// enabledDateTimeLayouts returns the built-in layouts found in formats, and whether other layouts are configured.
+func enabledDateTimeLayouts(formats []string) (enabled layoutMask, custom bool) {
+	for _, layout := range formats {
+		found := false
+		for i, builtin := range builtinDateTimeLayouts {
+			if layout == builtin {
+				enabled |= 1 << i
+				found = true
+				break
+			}
+		}
+		custom = custom || !found
+	}
+
+	return enabled, custom
+}
+
+// parseDateTimeLayouts parses a date-time in one pass, instead of trying every layout in formats (e.g. [DateTimeFormats]).
+//
+// It accepts exactly what [time.ParseInLocation] accepts for the built-in layouts enabled in formats,
+// with one deliberate exception: a sign after the decimal sign (e.g. "10:20:30.+12Z"), which [time.Parse]
+// tolerates for fixed-width fractions, is rejected.
+// Other layouts in formats are tried next, in their order of appearance.
+//
+// When no layout matches, the error reported by the scanner is returned.
+func parseDateTimeLayouts(data string, formats []string, loc *time.Location) (time.Time, error) {
+	enabled, custom := enabledDateTimeLayouts(formats)
+
+	t, accepted, scanErr := scanDateTime(data, loc)
+	if scanErr == nil {
+		if accepted&enabled != 0 {
+			return t, nil
+		}
+		scanErr = &DateTimeParseError{Value: data, Msg: "the layout of this date-time is not enabled in DateTimeFormats"}
+	}
+
+	if custom {
+		for _, layout := range formats {
+			if isBuiltinDateTimeLayout(layout) {
+				continue
+			}
+			if tt, err := time.ParseInLocation(layout, data, loc); err == nil {
+				return tt, nil
+			}
+		}
+	}
+
+	return time.Time{}, scanErr
+}
+
+func isBuiltinDateTimeLayout(layout string) bool {
+	for _, builtin := range builtinDateTimeLayouts {
+		if layout == builtin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dateTimeScanner reads a date-time from left to right.
+type dateTimeScanner struct {
+	data string
+	pos  int
+}
+
+func (s *dateTimeScanner) fail(msg string) *DateTimeParseError {
+	return &DateTimeParseError{Value: s.data, Pos: s.pos, Msg: msg}
+}
+
+// done tells if the whole input has been consumed.
+func (s *dateTimeScanner) done() bool {
+	return s.pos == len(s.data)
+}
+
+// peek returns the current character, or 0 at the end of the input.
+func (s *dateTimeScanner) peek() byte {
+	if s.done() {
+		return 0
+	}
+
+	return s.data[s.pos]
+}
+
+// skip consumes the expected character.
+func (s *dateTimeScanner) skip(c byte) bool {
+	if s.peek() != c {
+		return false
+	}
+	s.pos++
+
+	return true
+}
+
+// digits consumes exactly n digits.
+func (s *dateTimeScanner) digits(n int) (int, bool) {
+	if s.pos+n > len(s.data) {
+		return 0, false
+	}
+	v := 0
+	for i := range n {
+		c := s.data[s.pos+i]
+		if !isASCIIDigit(c) {
+			s.pos += i
+			return 0, false
+		}
+		v = v*decimalBase + int(c-'0')
+	}
+	s.pos += n
+
+	return v, true
+}
+
+// number consumes 1 up to max digits.
+func (s *dateTimeScanner) number(maxDigits int) (int, bool) {
+	v, n := 0, 0
+	for n < maxDigits && isASCIIDigit(s.peek()) {
+		v = v*decimalBase + int(s.data[s.pos]-'0')
+		s.pos++
+		n++
+	}
+
+	return v, n > 0
+}
+
+// scanDateTime recognizes all the built-in date-time layouts in a single pass.
+//
+// It returns the parsed time and the set of built-in layouts which accept the input.
+// On success, it does not allocate.
+//
+//nolint:gocognit,gocyclo,cyclop,funlen // a single pass over all supported layouts
+func scanDateTime(data string, loc *time.Location) (time.Time, layoutMask, error) {
+	s := dateTimeScanner{data: data}
+
+	// date: 2006-01-02
+	year, ok := s.digits(len("2006"))
+	if !ok {
+		return time.Time{}, 0, s.fail("expected a 4-digit year")
+	}
+	if !s.skip('-') {
+		return time.Time{}, 0, s.fail("expected '-'")
+	}
+	start := s.pos
+	month, ok := s.digits(len("01"))
+	if !ok {
+		return time.Time{}, 0, s.fail("expected a 2-digit month")
+	}
+	if month < int(time.January) || month > int(time.December) {
+		s.pos = start
+		return time.Time{}, 0, s.fail("month out of range")
+	}
+	if !s.skip('-') {
+		return time.Time{}, 0, s.fail("expected '-'")
+	}
+	start = s.pos
+	day, ok := s.digits(len("02"))
+	if !ok {
+		return time.Time{}, 0, s.fail("expected a 2-digit day")
+	}
+	if day < 1 || day > daysIn(time.Month(month), year) {
+		s.pos = start
+		return time.Time{}, 0, s.fail("day out of range")
+	}
+
+	if s.done() {
+		return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), maskUniversalSortableShort, nil
+	}
+
+	// separator
+	sep := s.peek()
+	if sep != 'T' && sep != ' ' {
+		return time.Time{}, 0, s.fail("expected 'T' or ' ' between date and time")
+	}
+	s.pos++
+	if sep == ' ' {
+		// like time.Parse, a space in a layout matches a run of spaces
+		for s.skip(' ') {
+		}
+	}
+
+	// time: 15:04[:05]
+	start = s.pos
+	hour, ok := s.number(len("15"))
+	if !ok {
+		return time.Time{}, 0, s.fail("expected an hour")
+	}
+	if hour >= hoursPerDay {
+		s.pos = start
+		return time.Time{}, 0, s.fail("hour out of range")
+	}
+	if !s.skip(':') {
+		return time.Time{}, 0, s.fail("expected ':'")
+	}
+	start = s.pos
+	minute, ok := s.digits(len("04"))
+	if !ok {
+		return time.Time{}, 0, s.fail("expected a 2-digit minute")
+	}
+	if minute >= minutesPerHour {
+		s.pos = start
+		return time.Time{}, 0, s.fail("minute out of range")
+	}
+
+	if sep == 'T' {
+		// reduced precision: 15:04 or 15:04Z
+		switch {
+		case s.done():
+			return time.Date(year, time.Month(month), day, hour, minute, 0, 0, loc), maskReducedPrecisionLocalTime, nil
+		case s.data[s.pos:] == "Z":
+			return time.Date(year, time.Month(month), day, hour, minute, 0, 0, loc), maskReducedPrecision, nil
+		}
+	}
+
+	if !s.skip(':') {
+		return time.Time{}, 0, s.fail("expected ':'")
+	}
+	start = s.pos
+	second, ok := s.digits(len("05"))
+	if !ok {
+		return time.Time{}, 0, s.fail("expected a 2-digit second")
+	}
+	if second >= secondsPerMinute {
+		s.pos = start
+		return time.Time{}, 0, s.fail("second out of range")
+	}
+
+	// fractional seconds, with any number of digits
+	var nsec, fracDigits int
+	if c := s.peek(); c == '.' || c == ',' {
+		s.pos++
+		for isASCIIDigit(s.peek()) {
+			if fracDigits < nanosecondsDigits {
+				nsec = nsec*decimalBase + int(s.data[s.pos]-'0')
+			}
+			fracDigits++
+			s.pos++
+		}
+		if fracDigits == 0 {
+			return time.Time{}, 0, s.fail("expected digits after the decimal sign")
+		}
+		for i := fracDigits; i < nanosecondsDigits; i++ {
+			nsec *= decimalBase
+		}
+	}
+
+	if s.done() {
+		mask := maskLocalTime
+		if sep == ' ' {
+			mask = maskUniversalSortable
+		}
+		return time.Date(year, time.Month(month), day, hour, minute, second, nsec, loc), mask, nil
+	}
+	if sep == ' ' {
+		return time.Time{}, 0, s.fail("unexpected trailing characters")
+	}
+
+	// fixed-width fractions only fit the millis and micros layouts
+	var colon, noColon layoutMask
+	switch fracDigits {
+	case millisDigits:
+		colon, noColon = maskRFC3339Millis, maskRFC3339MillisNoColon
+	case microsDigits:
+		colon, noColon = maskRFC3339Micro, maskRFC3339MicroNoColon
+	}
+
+	// offset: Z, -07:00 or -0700
+	if s.skip('Z') {
+		if !s.done() {
+			return time.Time{}, 0, s.fail("unexpected trailing characters")
+		}
+		return time.Date(year, time.Month(month), day, hour, minute, second, nsec, time.UTC), colon | noColon | maskRFC3339 | maskRFC3339Nano, nil
+	}
+
+	sign := s.peek()
+	if sign != '+' && sign != '-' {
+		return time.Time{}, 0, s.fail("expected 'Z' or a numerical offset")
+	}
+	s.pos++
+	start = s.pos
+	offsetHour, ok := s.digits(len("07"))
+	if !ok {
+		return time.Time{}, 0, s.fail("expected a 2-digit offset hour")
+	}
+	mask := colon | maskRFC3339 | maskRFC3339Nano
+	if !s.skip(':') {
+		mask = noColon
+	}
+	offsetMinute, ok := s.digits(len("00"))
+	if !ok {
+		return time.Time{}, 0, s.fail("expected a 2-digit offset minute")
+	}
+	if !s.done() {
+		return time.Time{}, 0, s.fail("unexpected trailing characters")
+	}
+	if offsetHour > hoursPerDay || offsetMinute > minutesPerHour {
+		s.pos = start
+		return time.Time{}, 0, s.fail("offset out of range")
+	}
+	if mask == 0 {
+		s.pos = start
+		return time.Time{}, 0, s.fail("an offset without colon requires 3 or 6 fractional digits")
+	}
+
+	offset := offsetHour*minutesPerHour + offsetMinute
+	if sign == '-' {
+		offset = -offset
+	}
+	t := time.Date(year, time.Month(month), day, hour, minute, second, nsec, time.UTC).Add(-time.Duration(offset) * time.Minute)
+
+	// like time.Parse, use the default location when it has the same offset at that instant
+	if _, localOffset := t.In(loc).Zone(); localOffset == offset*secondsPerMinute {
+		return t.In(loc), mask, nil
+	}
+
+	return t.In(fixedZone(offset)), mask, nil
+}
@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+var rxSignedFraction = regexp.MustCompile(`:[0-9]{2}[.,][+-]`)
+
+// parseDateTimeByLayouts is the reference implementation: it tries every layout in turn.
+func parseDateTimeByLayouts(data string) (time.Time, error) {
+	var lastError error
+	for _, layout := range DateTimeFormats {
+		dd, err := time.ParseInLocation(layout, data, DefaultTimeLocation)
+		if err != nil {
+			lastError = err
+			continue
+		}
+		return dd, nil
+	}
+	return time.Time{}, lastError
+}
+
+func parseDateTimeByScanner(data string) (time.Time, error) {
+	return parseDateTimeLayouts(data, DateTimeFormats, DefaultTimeLocation)
+}
+
+func scannerInputs() []string {
+	return []string{
+		// one sample per built-in layout
+		"2024-05-01T10:20:30.123456+02:00",
+		"2024-05-01T10:20:30.123456+0200",
+		"2024-05-01T10:20:30.123-07:00",
+		"2024-05-01T10:20:30.123-0700",
+		"2024-05-01T10:20:30Z",
+		"2024-05-01T10:20:30.123456789Z",
+		"2024-05-01T10:20:30",
+		"2024-05-01T10:20Z",
+		"2024-05-01T10:20",
+		"2024-05-01 10:20:30",
+		"2024-05-01",
+		// variations
+		"2024-05-01T10:20:30,5Z",
+		"2024-05-01T10:20:30.5",
+		"2024-05-01 10:20:30.5",
+		"2024-05-01T10:20:30.1234567891234Z",
+		"2024-05-01T10:20:30.123456Z",
+		"2024-05-01T10:20:30.123Z",
+		"2024-05-01T10:20:30.12Z",
+		"2024-05-01T10:20:30.12+0200",
+		"2024-05-01T10:20:30+0200",
+		"2024-05-01T10:20:30+00:00",
+		"2024-05-01T10:20:30-00:00",
+		"2024-05-01T10:20:30+24:00",
+		"2024-05-01T10:20:30+23:60",
+		"2024-05-01T10:20:30+25:00",
+		"2024-05-01T10:20:30+23:61",
+		"2024-05-01T1:20:30Z",
+		"2024-05-01 1:20:30",
+		"2024-05-01   10:20:30",
+		"2024-05-01T1:20",
+		"2024-02-29T00:00:00Z",
+		// invalid
+		"",
+		"2024",
+		"2024-05",
+		"2024-5-01",
+		"2024-05-1",
+		"2024-13-01",
+		"2023-02-29",
+		"2024-05-01T",
+		"2024-05-01t10:20:30Z",
+		"2024-05-01T10:20:30z",
+		"2024-05-01T24:00:00Z",
+		"2024-05-01T10:60:00Z",
+		"2024-05-01T10:20:60Z",
+		"2024-05-01T10:20:30.Z",
+		"2024-05-01T10:20:30.",
+		"2024-05-01T10:20:30,+12Z",
+		"2024-05-01T10:20:30.-00000+02:00",
+		"2024-05-01T10:20:30+02",
+		"2024-05-01T10:20:30+02:0",
+		"2024-05-01T10:20:30Zjunk",
+		"2024-05-01T10:20:30 ",
+		"2024-05-01 10:20:30Z",
+		"2024-05-01 10:20",
+		"2024-05-01T10:20ZZ",
+		"2024-05-01T10",
+		"2024-05-01X10:20:30Z",
+		"20240501T102030Z",
+		"1700000000",
+	}
+}
+
+func assertSameParse(t *testing.T, input string) {
+	t.Helper()
+
+	if rxSignedFraction.MatchString(input) {
+		// deliberately rejected by the scanner, although time.Parse tolerates it
+		_, err := parseDateTimeByScanner(input)
+		require.ErrorIs(t, err, ErrFormat)
+		return
+	}
+
+	expected, expectedErr := parseDateTimeByLayouts(input)
+	actual, actualErr := parseDateTimeByScanner(input)
+	if expectedErr != nil {
+		require.Errorf(t, actualErr, "expected %q to fail", input)
+		require.ErrorIs(t, actualErr, ErrFormat)
+		return
+	}
+
+	require.NoErrorf(t, actualErr, "expected %q to succeed", input)
+	assert.TrueTf(t, expected.Equal(actual), "expected %v, got %v for %q", expected, actual, input)
+	expectedName, expectedOffset := expected.Zone()
+	actualName, actualOffset := actual.Zone()
+	assert.EqualT(t, expectedName, actualName)
+	assert.EqualT(t, expectedOffset, actualOffset)
+	assert.EqualT(t, expected.Location() == DefaultTimeLocation, actual.Location() == DefaultTimeLocation)
+}
+
+func TestScanDateTime_SameAsLayouts(t *testing.T) {
+	for _, input := range scannerInputs() {
+		t.Run(input, func(t *testing.T) {
+			assertSameParse(t, input)
+		})
+	}
+
+	t.Run("with another default location", func(t *testing.T) {
+		paris, err := time.LoadLocation("Europe/Paris")
+		require.NoError(t, err)
+
+		old := DefaultTimeLocation
+		t.Cleanup(func() { DefaultTimeLocation = old })
+		DefaultTimeLocation = paris
+
+		for _, input := range scannerInputs() {
+			assertSameParse(t, input)
+		}
+	})
+
+	t.Run("with fewer layouts", func(t *testing.T) {
+		old := DateTimeFormats
+		t.Cleanup(func() { DateTimeFormats = old })
+		DateTimeFormats = []string{time.RFC3339}
+
+		for _, input := range scannerInputs() {
+			assertSameParse(t, input)
+		}
+
+		_, err := ParseDateTime("2024-05-01T10:20")
+		var parseErr *DateTimeParseError
+		require.ErrorAs(t, err, &parseErr)
+		assert.StringContainsT(t, parseErr.Msg, "not enabled")
+	})
+}
+
+func FuzzScanDateTime(f *testing.F) {
+	for _, input := range scannerInputs() {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		assertSameParse(t, input)
+	})
+}
+
+func TestScanDateTime_ErrorPosition(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		pos   int
+	}{
+		{"", 0},
+		{"2024/05/01", 4},
+		{"2024-13-01", 5},
+		{"2024-02-30", 8},
+		{"2024-05-01X10:20:30Z", 10},
+		{"2024-05-01T25:20:30Z", 11},
+		{"2024-05-01T10:2x:30Z", 15},
+		{"2024-05-01T10:20:30.Z", 20},
+		{"2024-05-01T10:20:30Zjunk", 20},
+		{"2024-05-01T10:20:30+0200", 20},
+		{"2024-05-01T10:20:30+25:00", 20},
+		{"2024-05-01T10:20:30~02:00", 19},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := ParseDateTime(tc.input + "")
+			if tc.input == "" {
+				// the empty string is the unix epoch
+				require.NoError(t, err)
+				return
+			}
+
+			var parseErr *DateTimeParseError
+			require.ErrorAs(t, err, &parseErr)
+			require.ErrorIs(t, err, ErrFormat)
+			assert.EqualT(t, tc.input, parseErr.Value)
+			assert.EqualT(t, tc.pos, parseErr.Pos)
+			assert.StringContainsT(t, err.Error(), fmt.Sprintf("at position %d", tc.pos))
+		})
+	}
+}
+
+func TestParseDateTime_CustomLayouts(t *testing.T) {
+	old := DateTimeFormats
+	t.Cleanup(func() { DateTimeFormats = old })
+	DateTimeFormats = append(slices.Clone(old), time.RFC1123Z)
+
+	dt, err := ParseDateTime("Wed, 01 May 2024 10:20:30 +0200")
+	require.NoError(t, err)
+	assert.TrueT(t, time.Time(dt).Equal(time.Date(2024, 5, 1, 8, 20, 30, 0, time.UTC)))
+
+	dt, err = ParseDateTime("2024-05-01T10:20:30Z")
+	require.NoError(t, err)
+	assert.TrueT(t, time.Time(dt).Equal(time.Date(2024, 5, 1, 10, 20, 30, 0, time.UTC)))
+
+	// the scanner error is reported when no layout matches
+	_, err = ParseDateTime("2024-05-01T10:20:30+0200")
+	var parseErr *DateTimeParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.EqualT(t, 20, parseErr.Pos)
+}
+
+func TestParseDateTime_NoAllocs(t *testing.T) {
+	for _, input := range []string{
+		"2024-05-01T10:20:30.123Z",
+		"2024-05-01T10:20:30.123456+02:00",
+		"2024-05-01 10:20:30",
+	} {
+		_, _ = ParseDateTime(input) // warms up the cache of fixed zones
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = ParseDateTime(input)
+		})
+		assert.EqualTf(t, float64(0), allocs, "expected no allocation when parsing %q", input)
+	}
+}
+
+func BenchmarkParseDateTime(b *testing.B) {
+	inputs := []string{
+		"2024-05-01T10:20:30.123456+02:00",
+		"2024-05-01T10:20:30.123Z",
+		"2024-05-01T10:20:30Z",
+		"2024-05-01T10:20:30.123456789Z",
+		"2024-05-01 10:20:30",
+		"2024-05-01",
+	}
+
+	b.Run("scanner", benchmarkParse(inputs, parseDateTimeByScanner))
+	b.Run("layouts", benchmarkParse(inputs, parseDateTimeByLayouts))
+}
+
+func benchmarkParse(input []string, fn func(string) (time.Time, error)) func(*testing.B) {
+	return func(b *testing.B) {
+		var (
+			tt time.Time
+			i  int
+		)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for b.Loop() {
+			tt, _ = fn(input[i%len(input)])
+			i++
+		}
+		fmt.Fprintln(io.Discard, tt)
+	}
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+//go:generate go run gen_tznames.go
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // resolves time zones on hosts without a zoneinfo database
+)
+
+func init() { //nolint:gochecknoinits // registers timezone format in the default registry
+	tz := TimeZone("")
+	Default.Add("timezone", &tz, timeZoneFormat{}.IsValid)
+}
+
+//nolint:gochecknoglobals // package-level configuration for time zone validation
+var (
+	// TimeZoneFixedOffsets enables fixed offsets such as "UTC+02:00", "UTC-05" or "GMT+05:30" as [TimeZone] values.
+	//
+	// By default, only the names of the IANA time zone database are accepted.
+	TimeZoneFixedOffsets = false
+
+	// locations caches the locations resolved by [TimeZone.Location].
+	locations sync.Map
+)
+
+const maxTimeZoneOffsetHours = 14
+
+// IsTimeZone returns true when the string is a valid time zone name, e.g. "Europe/Paris".
+//
+// Names are validated against the time zone database embedded in this package, which includes the
+// backward-compatible links and deprecated names (e.g. "Asia/Calcutta" or "US/Eastern").
+// Hence, the result does not depend on the zoneinfo database of the host.
+//
+// Fixed offsets such as "UTC+02:00" are accepted when [TimeZoneFixedOffsets] is enabled.
+func IsTimeZone(str string) bool {
+	_, err := ParseTimeZone(str)
+	return err == nil
+}
+
+// ParseTimeZone parses a string that represents a time zone name, e.g. "Europe/Paris".
+//
+// See [IsTimeZone] for the accepted values.
+func ParseTimeZone(data string) (TimeZone, error) {
+	if _, found := slices.BinarySearch(timeZoneNames[:], data); found {
+		return TimeZone(data), nil
+	}
+
+	if _, isOffset := parseTimeZoneOffset(data); isOffset {
+		if !TimeZoneFixedOffsets {
+			return "", fmt.Errorf("fixed offset time zones are disabled, cannot accept %q: %w", data, ErrFormat)
+		}
+
+		return TimeZone(data), nil
+	}
+
+	return "", fmt.Errorf("unknown time zone %q: %w", data, ErrFormat)
+}
+
+// parseTimeZoneOffset parses a fixed offset such as "UTC+02:00", "UTC-05" or "GMT+0530", returning the offset in seconds.
+func parseTimeZoneOffset(data string) (int, bool) {
+	rest, found := strings.CutPrefix(data, "UTC")
+	if !found {
+		if rest, found = strings.CutPrefix(data, "GMT"); !found {
+			return 0, false
+		}
+	}
+
+	if rest == "" || (rest[0] != '+' && rest[0] != '-') {
+		return 0, false
+	}
+	sign := 1
+	if rest[0] == '-' {
+		sign = -1
+	}
+	rest = rest[1:]
+
+	var hh, mm string
+	switch len(rest) {
+	case len("02"):
+		hh = rest
+	case len("0200"):
+		hh, mm = rest[:2], rest[2:]
+	case len("02:00"):
+		if rest[2] != ':' {
+			return 0, false
+		}
+		hh, mm = rest[:2], rest[3:]
+	default:
+		return 0, false
+	}
+
+	hours, ok := atoiDigits(hh)
+	if !ok || hours > maxTimeZoneOffsetHours {
+		return 0, false
+	}
+	var minutes int
+	if mm != "" {
+		if minutes, ok = atoiDigits(mm); !ok || minutes >= minutesPerHour {
+			return 0, false
+		}
+	}
+
+	return sign * (hours*minutesPerHour + minutes) * secondsPerMinute, true
+}
+
+// TimeZone represents a time zone name from the IANA time zone database, e.g. "Europe/Paris".
+//
+// swagger:strfmt timezone.
+type TimeZone string
+
+// timeZoneFormat describes the [TimeZone] format.
+type timeZoneFormat struct{}
+
+func (timeZoneFormat) FormatName() string { return "timezone" }
+
+func (timeZoneFormat) IsValid(s string) bool { return IsTimeZone(s) }
+
+// IsFixedOffset tells if the time zone is a fixed offset such as "UTC+02:00", rather than a name.
+func (z TimeZone) IsFixedOffset() bool {
+	_, isOffset := parseTimeZoneOffset(string(z))
+	return isOffset
+}
+
+// Location returns the [time.Location] for this time zone.
+//
+// Names are resolved with [time.LoadLocation], falling back to the embedded time zone database
+// when the host has no zoneinfo database. Fixed offsets are resolved with [time.FixedZone].
+func (z TimeZone) Location() (*time.Location, error) {
+	if v, ok := locations.Load(z); ok {
+		return v.(*time.Location), nil //nolint:forcetypeassert // the cache only holds locations
+	}
+
+	var loc *time.Location
+	if offset, isOffset := parseTimeZoneOffset(string(z)); isOffset {
+		loc = time.FixedZone(string(z), offset)
+	} else {
+		if _, err := ParseTimeZone(string(z)); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if loc, err = time.LoadLocation(string(z)); err != nil {
+			return nil, fmt.Errorf("loading time zone %q: %w: %w", z, err, ErrFormat)
+		}
+	}
+	locations.Store(z, loc)
+
+	return loc, nil
+}
+
+// MarshalText turns this instance into text.
+func (z TimeZone) MarshalText() ([]byte, error) {
+	return StringFormat[timeZoneFormat](z).MarshalText()
+}
+
+// UnmarshalText hydrates this instance from text.
+func (z *TimeZone) UnmarshalText(data []byte) error { // validated when ValidateOnUnmarshal is enabled
+	return (*StringFormat[timeZoneFormat])(z).UnmarshalText(data)
+}
+
+// Scan read a value from a database driver.
+func (z *TimeZone) Scan(raw any) error {
+	return (*StringFormat[timeZoneFormat])(z).Scan(raw)
+}
+
+// Value converts a value to a database driver value.
+func (z TimeZone) Value() (driver.Value, error) {
+	return StringFormat[timeZoneFormat](z).Value()
+}
+
+func (z TimeZone) String() string {
+	return string(z)
+}
+
+// MarshalJSON returns the [TimeZone] as JSON.
+func (z TimeZone) MarshalJSON() ([]byte, error) {
+	return StringFormat[timeZoneFormat](z).MarshalJSON()
+}
+
+// UnmarshalJSON sets the [TimeZone] from JSON.
+func (z *TimeZone) UnmarshalJSON(data []byte) error {
+	return (*StringFormat[timeZoneFormat])(z).UnmarshalJSON(data)
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (z *TimeZone) DeepCopyInto(out *TimeZone) {
+	(*StringFormat[timeZoneFormat])(z).DeepCopyInto((*StringFormat[timeZoneFormat])(out))
+}
+
+// DeepCopy copies the receiver into a new [TimeZone].
+func (z *TimeZone) DeepCopy() *TimeZone {
+	return (*TimeZone)((*StringFormat[timeZoneFormat])(z).DeepCopy())
+}
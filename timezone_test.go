@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func withTimeZoneFixedOffsets(t *testing.T, enabled bool) {
+	t.Helper()
+
+	orig := TimeZoneFixedOffsets
+	t.Cleanup(func() { TimeZoneFixedOffsets = orig })
+	TimeZoneFixedOffsets = enabled
+}
+
+func TestFormatTimeZone(t *testing.T) {
+	tz := TimeZone("Europe/Paris")
+	testStringFormat(t, &tz, "timezone", "America/New_York",
+		[]string{
+			"Europe/Paris",
+			"UTC",
+			"Etc/GMT+2",
+			"America/Argentina/Buenos_Aires",
+			// links and deprecated names
+			"Asia/Calcutta",
+			"US/Eastern",
+			"GB",
+		},
+		[]string{
+			"",
+			"europe/paris",
+			"Europe/Nowhere",
+			"Local",
+			"/etc/passwd",
+			"../Europe/Paris",
+			"UTC+02:00",
+		},
+	)
+}
+
+func TestTimeZone_Names(t *testing.T) {
+	assert.TrueT(t, slices.IsSorted(timeZoneNames[:]))
+
+	// every embedded name resolves to a location
+	for _, name := range timeZoneNames {
+		_, err := TimeZone(name).Location()
+		require.NoErrorf(t, err, "time zone %q", name)
+	}
+}
+
+func TestTimeZone_FixedOffsets(t *testing.T) {
+	withTimeZoneFixedOffsets(t, true)
+
+	for _, tc := range []struct {
+		in     string
+		offset int
+	}{
+		{"UTC+02:00", 2 * 60 * 60},
+		{"UTC-05", -5 * 60 * 60},
+		{"GMT+0530", (5*60 + 30) * 60},
+		{"UTC+14:00", 14 * 60 * 60},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			tz, err := ParseTimeZone(tc.in)
+			require.NoError(t, err)
+			assert.TrueT(t, tz.IsFixedOffset())
+
+			loc, err := tz.Location()
+			require.NoError(t, err)
+			_, offset := time.Date(2024, time.May, 1, 0, 0, 0, 0, loc).Zone()
+			assert.EqualT(t, tc.offset, offset)
+		})
+	}
+
+	for _, invalid := range []string{"UTC+", "UTC+2", "UTC+15:00", "UTC+02:60", "UTC+02-00", "CET+01:00", "+02:00"} {
+		assert.FalseTf(t, IsTimeZone(invalid), "expected %q to be invalid", invalid)
+	}
+}
+
+func TestTimeZone_Location(t *testing.T) {
+	loc, err := TimeZone("Europe/Paris").Location()
+	require.NoError(t, err)
+	assert.EqualT(t, "Europe/Paris", loc.String())
+	assert.FalseT(t, TimeZone("Europe/Paris").IsFixedOffset())
+
+	// cached
+	again, err := TimeZone("Europe/Paris").Location()
+	require.NoError(t, err)
+	assert.TrueT(t, loc == again)
+
+	_, err = TimeZone("Europe/Nowhere").Location()
+	require.ErrorIs(t, err, ErrFormat)
+}
@@ -126,6 +126,10 @@ func (u ULID) MarshalText() ([]byte, error) {
 
 // UnmarshalText hydrates this instance from text.
 func (u *ULID) UnmarshalText(data []byte) error { // validation is performed later on
+	if len(data) == 0 {
+		*u = NewULIDZero()
+		return nil
+	}
 	return u.ULID.UnmarshalText(data)
 }
 
@@ -161,6 +165,10 @@ func (u *ULID) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &ustr); err != nil {
 		return err
 	}
+	if ustr == "" {
+		*u = NewULIDZero()
+		return nil
+	}
 	id, err := ulid.ParseStrict(ustr)
 	if err != nil {
 		return fmt.Errorf("couldn't parse JSON value as ULID: %w", err)
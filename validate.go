@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ValidateOnUnmarshal enables the validation of formats based on a string, e.g. [Email] or [IPv4],
+// when they are unmarshaled from JSON, text, BSON or scanned from a database.
+//
+// By default, these formats accept any string and are validated later on, e.g. by go-swagger generated models.
+// When enabled, invalid values yield a [*ValidationError] and leave the receiver unchanged.
+// Empty values are always accepted, as the zero value of the format.
+//
+// Other formats, e.g. [DateTime] or [ULID], are always validated when unmarshaled.
+var ValidateOnUnmarshal = false //nolint:gochecknoglobals // package-level configuration, like other settings of this package
+
+// ValidationError is returned when a value is not valid for its format.
+//
+// It wraps [ErrFormat].
+type ValidationError struct {
+	// Format is the name of the format, e.g. "email".
+	Format string
+
+	// Value is the invalid value. It is left empty for sensitive formats, e.g. "creditcard".
+	Value string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("invalid %s", e.Format)
+	}
+
+	return fmt.Sprintf("invalid %s %q", e.Format, e.Value)
+}
+
+// Unwrap returns [ErrFormat].
+func (e *ValidationError) Unwrap() error {
+	return ErrFormat
+}
+
+// unmarshaled assigns a value being unmarshaled, after validating it when [ValidateOnUnmarshal] is enabled.
+//
+// The zero value, i.e. empty text, is never rejected.
+func unmarshaled[T interface {
+	comparable
+	Validate() error
+}](dst *T, v T,
+) error {
+	var zero T
+	if ValidateOnUnmarshal && v != zero {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+	*dst = v
+
+	return nil
+}
+
+// Validate checks that the value is a valid [URI].
+func (u URI) Validate() error {
+	return StringFormat[uriFormat](u).Validate()
+}
+
+// Validate checks that the value is a valid [Email].
+func (e Email) Validate() error {
+	return StringFormat[emailFormat](e).Validate()
+}
+
+// Validate checks that the value is a valid [Hostname].
+func (h Hostname) Validate() error {
+	return StringFormat[hostnameFormat](h).Validate()
+}
+
+// Validate checks that the value is a valid [IPv4].
+func (u IPv4) Validate() error {
+	return StringFormat[ipv4Format](u).Validate()
+}
+
+// Validate checks that the value is a valid [IPv6].
+func (u IPv6) Validate() error {
+	return StringFormat[ipv6Format](u).Validate()
+}
+
+// Validate checks that the value is a valid [CIDR].
+func (u CIDR) Validate() error {
+	return StringFormat[cidrFormat](u).Validate()
+}
+
+// Validate checks that the value is a valid [MAC].
+func (u MAC) Validate() error {
+	return StringFormat[macFormat](u).Validate()
+}
+
+// Validate checks that the value is a valid [UUID].
+func (u UUID) Validate() error {
+	return StringFormat[uuidFormat](u).Validate()
+}
+
+// Validate checks that the value is a valid [UUID3].
+func (u UUID3) Validate() error {
+	return StringFormat[uuid3Format](u).Validate()
+}
+
+// Validate checks that the value is a valid [UUID4].
+func (u UUID4) Validate() error {
+	return StringFormat[uuid4Format](u).Validate()
+}
+
+// Validate checks that the value is a valid [UUID5].
+func (u UUID5) Validate() error {
+	return StringFormat[uuid5Format](u).Validate()
+}
+
+// Validate checks that the value is a valid [UUID7].
+func (u UUID7) Validate() error {
+	return StringFormat[uuid7Format](u).Validate()
+}
+
+// Validate checks that the value is a valid [ISBN], either an ISBN-10 or an ISBN-13.
+func (u ISBN) Validate() error {
+	return StringFormat[isbnFormat](u).Validate()
+}
+
+// Validate checks that the value is a valid [ISBN10].
+func (u ISBN10) Validate() error {
+	return StringFormat[isbn10Format](u).Validate()
+}
+
+// Validate checks that the value is a valid [ISBN13].
+func (u ISBN13) Validate() error {
+	return StringFormat[isbn13Format](u).Validate()
+}
+
+// Validate checks that the value is a valid [CreditCard]. The error does not hold the number.
+func (u CreditCard) Validate() error {
+	return StringFormat[creditCardFormat](u).Validate()
+}
+
+// Validate checks that the value is a valid [SSN]. The error does not hold the number.
+func (u SSN) Validate() error {
+	return StringFormat[ssnFormat](u).Validate()
+}
+
+// Validate checks that the value is a valid [HexColor].
+func (h HexColor) Validate() error {
+	return StringFormat[hexColorFormat](h).Validate()
+}
+
+// Validate checks that the value is a valid [RGBColor].
+func (r RGBColor) Validate() error {
+	return StringFormat[rgbColorFormat](r).Validate()
+}
+
+// Validate always succeeds: any string is a valid [Password].
+func (p Password) Validate() error {
+	return StringFormat[passwordFormat](p).Validate()
+}
+
+// Validate checks that the value is a valid [Cron] expression.
+func (c Cron) Validate() error {
+	return StringFormat[cronFormat](c).Validate()
+}
+
+// Validate checks that the value is a valid [TimeZone], along [TimeZoneFixedOffsets].
+func (z TimeZone) Validate() error {
+	return StringFormat[timeZoneFormat](z).Validate()
+}
+
+// Validate always succeeds: a [Base64] is validated when unmarshaled.
+func (b Base64) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [DateTime] is validated when unmarshaled.
+func (t DateTime) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [Date] is validated when unmarshaled.
+func (d Date) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: an [ExactDateTime] is validated when unmarshaled.
+func (t ExactDateTime) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: an [HTTPDate] is validated when unmarshaled.
+func (d HTTPDate) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [ZonedDateTime] is validated when unmarshaled.
+func (z ZonedDateTime) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [Duration] is validated when unmarshaled.
+func (d Duration) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: an [Interval] is validated when unmarshaled.
+func (i Interval) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [RepeatingInterval] is validated when unmarshaled.
+func (r RepeatingInterval) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [CivilDate] is validated when unmarshaled.
+func (d CivilDate) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [YearMonth] is validated when unmarshaled.
+func (ym YearMonth) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [Year] is validated when unmarshaled.
+func (y Year) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [RRule] is validated when unmarshaled.
+func (r RRule) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: a [ULID] is validated when unmarshaled.
+func (u ULID) Validate() error {
+	return nil
+}
+
+// Validate always succeeds: an [ObjectId] is validated when unmarshaled.
+func (id ObjectId) Validate() error { //nolint:revive
+	return nil
+}
+
+// checkValue validates a value parsed by a registry created with [WithValidation].
+//
+// Dates, date-times and durations are validated while parsed, along the settings of the registry.
+func (f *defaultFormats) checkValue(v knownFormat, name, data string) error {
+	if !f.validateValues {
+		return nil
+	}
+	switch {
+	case v.Name == "date" && v.Type == reflect.TypeFor[Date](),
+		v.Name == "datetime" && v.Type == reflect.TypeFor[DateTime](),
+		v.Name == "duration" && v.Type == reflect.TypeFor[Duration]():
+		return nil
+	}
+	if f.validates(v, data) {
+		return nil
+	}
+	if v.Name == "creditcard" || v.Name == "ssn" {
+		data = ""
+	}
+
+	return &ValidationError{Format: name, Value: data}
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+func withValidateOnUnmarshal(t *testing.T) {
+	t.Helper()
+
+	old := ValidateOnUnmarshal
+	t.Cleanup(func() { ValidateOnUnmarshal = old })
+	ValidateOnUnmarshal = true
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		valid   interface{ Validate() error }
+		invalid interface{ Validate() error }
+	}{
+		{URI("https://example.com"), URI("relative")},
+		{Email("john@example.com"), Email("john")},
+		{Hostname("example.com"), Hostname("")},
+		{IPv4("192.0.2.1"), IPv4("192.0.2")},
+		{IPv6("2001:db8::1"), IPv6("192.0.2.1")},
+		{CIDR("192.0.2.0/24"), CIDR("192.0.2.0")},
+		{MAC("01:23:45:67:89:ab"), MAC("01:23")},
+		{UUID("f47ac10b-58cc-4372-a567-0e02b2c3d479"), UUID("f47ac10b")},
+		{UUID3("a3bb189e-8bf9-3888-9912-ace4e6543002"), UUID3("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+		{UUID4("f47ac10b-58cc-4372-a567-0e02b2c3d479"), UUID4("a3bb189e-8bf9-3888-9912-ace4e6543002")},
+		{UUID5("a6edc906-2f9f-5fb2-a373-efac406f0ef2"), UUID5("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+		{UUID7("01890a5d-ac96-774b-bcce-b302099a8057"), UUID7("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+		{ISBN("978-0-306-40615-7"), ISBN("978-0-306-40615-8")},
+		{ISBN10("0306406152"), ISBN10("9780306406157")},
+		{ISBN13("9780306406157"), ISBN13("0306406152")},
+		{CreditCard("4111 1111 1111 1111"), CreditCard("4111 1111 1111 1112")},
+		{SSN("123-45-6789"), SSN("123456789")},
+		{HexColor("#abc"), HexColor("#abcd")},
+		{RGBColor("rgb(1, 2, 3)"), RGBColor("rgb(256, 2, 3)")},
+		{Cron("@daily"), Cron("* *")},
+		{TimeZone("Europe/Paris"), TimeZone("Mars/Olympus")},
+	} {
+		require.NoErrorf(t, tc.valid.Validate(), "value: %v", tc.valid)
+
+		err := tc.invalid.Validate()
+		require.ErrorIsf(t, err, ErrFormat, "value: %v", tc.invalid)
+		var verr *ValidationError
+		require.TrueT(t, errors.As(err, &verr))
+		assert.NotEmpty(t, verr.Format)
+	}
+
+	t.Run("should not echo sensitive values", func(t *testing.T) {
+		err := CreditCard("4111 1111 1111 1112").Validate()
+		assert.EqualT(t, "invalid creditcard", err.Error())
+		assert.NotContains(t, SSN("123456789").Validate().Error(), "123")
+	})
+
+	t.Run("should always accept parsed formats", func(t *testing.T) {
+		for _, v := range []interface{ Validate() error }{
+			Password(""), Base64(nil), DateTime{}, Date{}, ExactDateTime{}, HTTPDate{}, ZonedDateTime{}, Duration(0),
+			Interval{}, RepeatingInterval{}, CivilDate{}, YearMonth{}, Year(0), RRule{}, ULID{}, ObjectId{},
+		} {
+			require.NoError(t, v.Validate())
+		}
+	})
+}
+
+func TestValidateOnUnmarshal(t *testing.T) {
+	type contact struct {
+		Email Email `json:"email"`
+		IP    *IPv4 `json:"ip,omitempty"`
+	}
+
+	t.Run("should accept anything by default", func(t *testing.T) {
+		var c contact
+		require.NoError(t, json.Unmarshal([]byte(`{"email":"john","ip":"nope"}`), &c))
+		assert.EqualT(t, Email("john"), c.Email)
+	})
+
+	withValidateOnUnmarshal(t)
+
+	t.Run("should validate JSON", func(t *testing.T) {
+		var c contact
+		require.NoError(t, json.Unmarshal([]byte(`{"email":"john@example.com","ip":null}`), &c))
+		assert.EqualT(t, Email("john@example.com"), c.Email)
+
+		err := json.Unmarshal([]byte(`{"email":"john"}`), &c)
+		require.ErrorIs(t, err, ErrFormat)
+		var verr *ValidationError
+		require.TrueT(t, errors.As(err, &verr))
+		assert.EqualT(t, ValidationError{Format: "email", Value: "john"}, *verr)
+		assert.EqualT(t, `invalid email "john"`, verr.Error())
+		assert.EqualT(t, Email("john@example.com"), c.Email, "the receiver should be left unchanged")
+
+		require.ErrorIs(t, json.Unmarshal([]byte(`{"email":"john@example.com","ip":"::1"}`), &c), ErrFormat)
+	})
+
+	t.Run("should validate text", func(t *testing.T) {
+		var mac MAC
+		require.NoError(t, mac.UnmarshalText([]byte("01:23:45:67:89:ab")))
+		require.ErrorIs(t, mac.UnmarshalText([]byte("01:23")), ErrFormat)
+		assert.EqualT(t, MAC("01:23:45:67:89:ab"), mac)
+
+		var zone TimeZone
+		require.ErrorIs(t, zone.UnmarshalText([]byte("Mars/Olympus")), ErrFormat)
+	})
+
+	t.Run("should validate SQL values", func(t *testing.T) {
+		var id UUID
+		require.NoError(t, id.Scan("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+		require.ErrorIs(t, id.Scan([]byte("f47ac10b")), ErrFormat)
+		assert.EqualT(t, UUID("f47ac10b-58cc-4372-a567-0e02b2c3d479"), id)
+
+		var cron Cron
+		require.ErrorIs(t, cron.Scan("* *"), ErrFormat)
+	})
+
+	t.Run("should validate BSON", func(t *testing.T) {
+		valid, err := Hostname("example.com").MarshalBSON()
+		require.NoError(t, err)
+		invalid, err := Hostname("-invalid-").MarshalBSON()
+		require.NoError(t, err)
+
+		var h Hostname
+		require.NoError(t, h.UnmarshalBSON(valid))
+		require.ErrorIs(t, h.UnmarshalBSON(invalid), ErrFormat)
+		assert.EqualT(t, Hostname("example.com"), h)
+	})
+}
+
+func TestWithValidation(t *testing.T) {
+	registry := NewFormats(WithValidation())
+
+	t.Run("should validate parsed values", func(t *testing.T) {
+		_, err := registry.Parse("ipv4", "192.0.2.1")
+		require.NoError(t, err)
+
+		_, err = registry.Parse("ipv4", "192.0.2")
+		var verr *ValidationError
+		require.TrueT(t, errors.As(err, &verr))
+		assert.EqualT(t, "ipv4", verr.Format)
+
+		_, err = NewFormats().Parse("ipv4", "192.0.2")
+		require.NoError(t, err)
+	})
+
+	t.Run("should use the validators of the registry", func(t *testing.T) {
+		registry := NewFormats(WithValidation())
+		pw := Password("")
+		registry.Add("pin", &pw, func(s string) bool { return len(s) == 4 })
+
+		_, err := registry.Parse("pin", "1234")
+		require.NoError(t, err)
+		_, err = registry.Parse("pin", "12345")
+		require.ErrorIs(t, err, ErrFormat)
+	})
+
+	t.Run("should validate decoded values", func(t *testing.T) {
+		type layout struct {
+			Email Email `json:"email"`
+			Card  CreditCard
+		}
+
+		decode := func(input map[string]any) error {
+			var result layout
+			d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+				DecodeHook: registry.MapStructureHookFunc(),
+				Result:     &result,
+			})
+			require.NoError(t, err)
+
+			return d.Decode(input)
+		}
+
+		require.NoError(t, decode(map[string]any{"email": "john@example.com", "card": "4111 1111 1111 1111"}))
+		require.ErrorIs(t, decode(map[string]any{"email": "john"}), ErrFormat)
+
+		err := decode(map[string]any{"card": "4111 1111 1111 1112"})
+		require.ErrorIs(t, err, ErrFormat)
+		assert.NotContains(t, err.Error(), "4111")
+	})
+}
@@ -0,0 +1,411 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+func init() { //nolint:gochecknoinits // registers year-month and year formats in the default registry
+	ym := YearMonth{}
+	Default.Add("year-month", &ym, IsYearMonth)
+
+	y := Year(0)
+	Default.Add("year", &y, IsYear)
+}
+
+const (
+	daysInCommonYear = 365
+	daysInDecember   = 31
+)
+
+// IsYearMonth returns true when the string is a valid year and month, e.g. "2024-05".
+func IsYearMonth(str string) bool {
+	_, err := ParseYearMonth(str)
+	return err == nil
+}
+
+// IsYear returns true when the string is a valid 4-digit year, e.g. "2024".
+func IsYear(str string) bool {
+	_, err := ParseYear(str)
+	return err == nil
+}
+
+// YearMonth represents a calendar month, e.g. "2024-05".
+//
+// This is an ISO 8601 calendar date with reduced precision. It does not depend on any time zone.
+//
+// swagger:strfmt year-month.
+type YearMonth struct {
+	year  int
+	month time.Month
+}
+
+// NewYearMonth builds a [YearMonth].
+//
+// Like [time.Date], months outside of their usual range are normalized, e.g. month 13 is January of the next year.
+func NewYearMonth(year int, month time.Month) YearMonth {
+	t := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	return YearMonth{year: t.Year(), month: t.Month()}
+}
+
+// YearMonthOf returns the [YearMonth] of a [DateTime], as seen in the location of that [DateTime].
+func YearMonthOf(t DateTime) YearMonth {
+	tt := time.Time(t)
+
+	return YearMonth{year: tt.Year(), month: tt.Month()}
+}
+
+// ParseYearMonth parses a string that represents a year and a month, e.g. "2024-05".
+func ParseYearMonth(data string) (YearMonth, error) {
+	const (
+		yearMonthLen = len(ISO8601YearMonth)
+		yearLen      = 4
+	)
+	if len(data) != yearMonthLen || data[yearLen] != '-' {
+		return YearMonth{}, fmt.Errorf("invalid year-month %q: expected %q: %w", data, ISO8601YearMonth, ErrFormat)
+	}
+	year, okYear := atoiDigits(data[:yearLen])
+	month, okMonth := atoiDigits(data[yearLen+1:])
+	if !okYear || !okMonth || month < int(time.January) || month > int(time.December) {
+		return YearMonth{}, fmt.Errorf("invalid year-month %q: %w", data, ErrFormat)
+	}
+
+	return YearMonth{year: year, month: time.Month(month)}, nil
+}
+
+// Year returns the year.
+func (ym YearMonth) Year() int {
+	return ym.year
+}
+
+// Month returns the month.
+func (ym YearMonth) Month() time.Month {
+	return ym.month
+}
+
+// FirstDay returns the first day of the month.
+func (ym YearMonth) FirstDay() Date {
+	return Date(time.Date(ym.year, ym.month, 1, 0, 0, 0, 0, DefaultTimeLocation))
+}
+
+// LastDay returns the last day of the month.
+func (ym YearMonth) LastDay() Date {
+	return Date(time.Date(ym.year, ym.month+1, 0, 0, 0, 0, 0, DefaultTimeLocation))
+}
+
+// Days returns the number of days in the month.
+func (ym YearMonth) Days() int {
+	return time.Date(ym.year, ym.month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// AddMonths returns the [YearMonth] n months later (or earlier, when n is negative).
+func (ym YearMonth) AddMonths(n int) YearMonth {
+	return NewYearMonth(ym.year, ym.month+time.Month(n))
+}
+
+// Start returns the first instant of the month in [DefaultTimeLocation].
+func (ym YearMonth) Start() DateTime {
+	return DateTime(time.Time(ym.FirstDay()))
+}
+
+// End returns the first instant of the next month in [DefaultTimeLocation].
+func (ym YearMonth) End() DateTime {
+	return DateTime(time.Time(ym.AddMonths(1).FirstDay()))
+}
+
+// Contains tells if the instant t lies within the month, in [DefaultTimeLocation].
+func (ym YearMonth) Contains(t DateTime) bool {
+	return ym.Interval().Contains(t)
+}
+
+// Interval returns the month as an [Interval], e.g. "2024-05-01/P1M".
+func (ym YearMonth) Interval() Interval {
+	return calendarInterval(time.Time(ym.FirstDay()), period{months: 1})
+}
+
+// IsZero returns whether the year-month is a zero value.
+func (ym YearMonth) IsZero() bool {
+	return ym == YearMonth{}
+}
+
+// Equal checks if two [YearMonth] instances are equal.
+func (ym YearMonth) Equal(other YearMonth) bool {
+	return ym == other
+}
+
+// String converts this year-month to a string.
+//
+// The zero [YearMonth] renders as an empty string.
+func (ym YearMonth) String() string {
+	if ym.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%04d-%02d", ym.year, ym.month)
+}
+
+// MarshalText serializes this year-month to string.
+func (ym YearMonth) MarshalText() ([]byte, error) {
+	return []byte(ym.String()), nil
+}
+
+// UnmarshalText parses a text representation into a year-month.
+func (ym *YearMonth) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*ym = YearMonth{}
+		return nil
+	}
+	v, err := ParseYearMonth(string(text))
+	if err != nil {
+		return err
+	}
+	*ym = v
+	return nil
+}
+
+// Scan scans a [YearMonth] value from database driver type.
+func (ym *YearMonth) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return ym.UnmarshalText(v)
+	case string:
+		return ym.UnmarshalText([]byte(v))
+	case time.Time:
+		*ym = YearMonth{year: v.Year(), month: v.Month()}
+		return nil
+	case nil:
+		*ym = YearMonth{}
+		return nil
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.YearMonth from: %#v: %w", v, ErrFormat)
+	}
+}
+
+// Value converts [YearMonth] to a primitive value ready to written to a database.
+func (ym YearMonth) Value() (driver.Value, error) {
+	return driver.Value(ym.String()), nil
+}
+
+// MarshalJSON returns the [YearMonth] as JSON.
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ym.String())
+}
+
+// UnmarshalJSON sets the [YearMonth] from JSON.
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return ym.UnmarshalText([]byte(str))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (ym *YearMonth) DeepCopyInto(out *YearMonth) {
+	*out = *ym
+}
+
+// DeepCopy copies the receiver into a new [YearMonth].
+func (ym *YearMonth) DeepCopy() *YearMonth {
+	if ym == nil {
+		return nil
+	}
+	out := new(YearMonth)
+	ym.DeepCopyInto(out)
+	return out
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (ym YearMonth) GobEncode() ([]byte, error) {
+	return ym.MarshalText()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (ym *YearMonth) GobDecode(data []byte) error {
+	return ym.UnmarshalText(data)
+}
+
+// Year represents a calendar year, e.g. "2024".
+//
+// This is an ISO 8601 calendar date with reduced precision. It does not depend on any time zone.
+//
+// swagger:strfmt year.
+type Year int
+
+// YearOf returns the [Year] of a [DateTime], as seen in the location of that [DateTime].
+func YearOf(t DateTime) Year {
+	return Year(time.Time(t).Year())
+}
+
+// ParseYear parses a string that represents a 4-digit year, e.g. "2024".
+func ParseYear(data string) (Year, error) {
+	const yearLen = len(ISO8601Year)
+	if len(data) != yearLen {
+		return 0, fmt.Errorf("invalid year %q: expected %q: %w", data, ISO8601Year, ErrFormat)
+	}
+	year, ok := atoiDigits(data)
+	if !ok {
+		return 0, fmt.Errorf("invalid year %q: %w", data, ErrFormat)
+	}
+
+	return Year(year), nil
+}
+
+// IsLeap tells if the year is a leap year in the Gregorian calendar.
+func (y Year) IsLeap() bool {
+	return y.Days() > daysInCommonYear
+}
+
+// Days returns the number of days in the year.
+func (y Year) Days() int {
+	return time.Date(int(y), time.December, daysInDecember, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// Month returns the given month of this year.
+func (y Year) Month(month time.Month) YearMonth {
+	return NewYearMonth(int(y), month)
+}
+
+// FirstDay returns January 1st of the year.
+func (y Year) FirstDay() Date {
+	return Date(time.Date(int(y), time.January, 1, 0, 0, 0, 0, DefaultTimeLocation))
+}
+
+// LastDay returns December 31st of the year.
+func (y Year) LastDay() Date {
+	return Date(time.Date(int(y), time.December, daysInDecember, 0, 0, 0, 0, DefaultTimeLocation))
+}
+
+// Start returns the first instant of the year in [DefaultTimeLocation].
+func (y Year) Start() DateTime {
+	return DateTime(time.Time(y.FirstDay()))
+}
+
+// End returns the first instant of the next year in [DefaultTimeLocation].
+func (y Year) End() DateTime {
+	return DateTime(time.Time((y + 1).FirstDay()))
+}
+
+// Contains tells if the instant t lies within the year, in [DefaultTimeLocation].
+func (y Year) Contains(t DateTime) bool {
+	return y.Interval().Contains(t)
+}
+
+// Interval returns the year as an [Interval], e.g. "2024-01-01/P1Y".
+func (y Year) Interval() Interval {
+	return calendarInterval(time.Time(y.FirstDay()), period{years: 1})
+}
+
+// IsZero returns whether the year is a zero value.
+func (y Year) IsZero() bool {
+	return y == 0
+}
+
+// Equal checks if two [Year] instances are equal.
+func (y Year) Equal(other Year) bool {
+	return y == other
+}
+
+// String converts this year to a string.
+func (y Year) String() string {
+	return fmt.Sprintf("%04d", int(y))
+}
+
+// MarshalText serializes this year to string.
+func (y Year) MarshalText() ([]byte, error) {
+	return []byte(y.String()), nil
+}
+
+// UnmarshalText parses a text representation into a year.
+func (y *Year) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*y = 0
+		return nil
+	}
+	v, err := ParseYear(string(text))
+	if err != nil {
+		return err
+	}
+	*y = v
+	return nil
+}
+
+// Scan scans a [Year] value from database driver type.
+func (y *Year) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return y.UnmarshalText(v)
+	case string:
+		return y.UnmarshalText([]byte(v))
+	case int64:
+		*y = Year(v)
+		return nil
+	case time.Time:
+		*y = Year(v.Year())
+		return nil
+	case nil:
+		*y = Year(0)
+		return nil
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Year from: %#v: %w", v, ErrFormat)
+	}
+}
+
+// Value converts [Year] to a primitive value ready to written to a database.
+func (y Year) Value() (driver.Value, error) {
+	return driver.Value(y.String()), nil
+}
+
+// MarshalJSON returns the [Year] as JSON.
+func (y Year) MarshalJSON() ([]byte, error) {
+	return json.Marshal(y.String())
+}
+
+// UnmarshalJSON sets the [Year] from JSON.
+func (y *Year) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return y.UnmarshalText([]byte(str))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (y *Year) DeepCopyInto(out *Year) {
+	*out = *y
+}
+
+// DeepCopy copies the receiver into a new [Year].
+func (y *Year) DeepCopy() *Year {
+	if y == nil {
+		return nil
+	}
+	out := new(Year)
+	y.DeepCopyInto(out)
+	return out
+}
+
+// calendarInterval builds an [Interval] starting on a date and spanning a calendar period.
+func calendarInterval(start time.Time, p period) Interval {
+	end, _ := p.addTo(start, 1) // a single period does not overflow
+
+	return Interval{
+		start:     start,
+		end:       end,
+		period:    p,
+		form:      intervalStartDuration,
+		startDate: true,
+	}
+}
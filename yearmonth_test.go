@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+var (
+	_ sql.Scanner   = &YearMonth{}
+	_ driver.Valuer = YearMonth{}
+	_ sql.Scanner   = (*Year)(nil)
+	_ driver.Valuer = Year(0)
+)
+
+func TestYearMonth(t *testing.T) {
+	const orig = "2024-02"
+	bj := []byte(`"` + orig + `"`)
+
+	ym, err := ParseYearMonth(orig)
+	require.NoError(t, err)
+	assert.EqualT(t, 2024, ym.Year())
+	assert.EqualT(t, time.February, ym.Month())
+	assert.EqualT(t, orig, ym.String())
+	assert.EqualT(t, 29, ym.Days())
+	assert.EqualT(t, "2024-02-01", ym.FirstDay().String())
+	assert.EqualT(t, "2024-02-29", ym.LastDay().String())
+	assert.EqualT(t, "2025-01", ym.AddMonths(11).String())
+	assert.EqualT(t, "2023-12", ym.AddMonths(-2).String())
+	assert.EqualT(t, NewYearMonth(2025, 1), NewYearMonth(2024, 13))
+
+	t.Run("range semantics", func(t *testing.T) {
+		assert.EqualT(t, "2024-02-01/P1M", ym.Interval().String())
+		assert.TrueT(t, ym.Start().Equal(DateTime(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))))
+		assert.TrueT(t, ym.End().Equal(DateTime(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))))
+		assert.TrueT(t, ym.Contains(DateTime(time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC))))
+		assert.FalseT(t, ym.Contains(DateTime(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))))
+
+		paris := time.FixedZone("CET", 3600)
+		dt := DateTime(time.Date(2024, 3, 1, 0, 30, 0, 0, paris))
+		assert.EqualT(t, "2024-03", YearMonthOf(dt).String(), "YearMonthOf uses the location of the DateTime")
+		assert.TrueT(t, ym.Contains(dt), "Contains compares instants in DefaultTimeLocation")
+	})
+
+	t.Run("encoding", func(t *testing.T) {
+		var v YearMonth
+		require.NoError(t, v.UnmarshalText([]byte(orig)))
+		assert.TrueT(t, v.Equal(ym))
+		txt, err := v.MarshalText()
+		require.NoError(t, err)
+		assert.EqualT(t, orig, string(txt))
+		require.NoError(t, v.UnmarshalText(nil))
+		assert.TrueT(t, v.IsZero())
+
+		var j YearMonth
+		require.NoError(t, j.UnmarshalJSON(bj))
+		assert.TrueT(t, j.Equal(ym))
+		assert.JSONMarshalAsT(t, string(bj), j)
+		require.NoError(t, j.UnmarshalJSON([]byte(jsonNull)))
+		require.Error(t, j.UnmarshalJSON([]byte(`"2024-13"`)))
+		require.Error(t, j.UnmarshalJSON([]byte(`2024`)))
+
+		for _, value := range []any{orig, []byte(orig), time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)} {
+			var s YearMonth
+			require.NoError(t, s.Scan(value))
+			assert.TrueT(t, s.Equal(ym), "value: %#v", value)
+		}
+		var s YearMonth
+		require.NoError(t, s.Scan(nil))
+		assert.TrueT(t, s.IsZero())
+		require.Error(t, s.Scan(202402))
+
+		dbv, err := ym.Value()
+		require.NoError(t, err)
+		assert.EqualValues(t, orig, dbv)
+
+		var zero YearMonth
+		assert.EqualT(t, "", zero.String())
+		zj, err := json.Marshal(zero)
+		require.NoError(t, err)
+		assert.EqualT(t, `""`, string(zj))
+		j = ym
+		require.NoError(t, json.Unmarshal(zj, &j))
+		assert.TrueT(t, j.IsZero(), "the zero value should round-trip")
+
+		out := ym.DeepCopy()
+		assert.Equal(t, &ym, out)
+		var inNil *YearMonth
+		assert.Nil(t, inNil.DeepCopy())
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		testValid(t, "year-month", orig)
+		testValid(t, "yearmonth", "0001-12")
+		for _, value := range []string{"2024-00", "2024-13", "2024-1", "202402", "24-02", "2024/02", "2024-02-01", "yada"} {
+			testInvalid(t, "year-month", value)
+		}
+	})
+}
+
+func TestYear(t *testing.T) {
+	const orig = "2024"
+	bj := []byte(`"` + orig + `"`)
+
+	y, err := ParseYear(orig)
+	require.NoError(t, err)
+	assert.EqualT(t, Year(2024), y)
+	assert.EqualT(t, orig, y.String())
+	assert.TrueT(t, y.IsLeap())
+	assert.FalseT(t, Year(1900).IsLeap())
+	assert.TrueT(t, Year(2000).IsLeap())
+	assert.EqualT(t, 366, y.Days())
+	assert.EqualT(t, "2024-01-01", y.FirstDay().String())
+	assert.EqualT(t, "2024-12-31", y.LastDay().String())
+	assert.EqualT(t, "2024-07", y.Month(time.July).String())
+	assert.EqualT(t, "0042", Year(42).String())
+
+	t.Run("range semantics", func(t *testing.T) {
+		assert.EqualT(t, "2024-01-01/P1Y", y.Interval().String())
+		assert.TrueT(t, y.Start().Equal(DateTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))))
+		assert.TrueT(t, y.End().Equal(DateTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))))
+		assert.TrueT(t, y.Contains(DateTime(time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC))))
+		assert.FalseT(t, y.Contains(DateTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))))
+		assert.EqualT(t, y, YearOf(DateTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))))
+	})
+
+	t.Run("encoding", func(t *testing.T) {
+		var v Year
+		require.NoError(t, v.UnmarshalText([]byte(orig)))
+		assert.TrueT(t, v.Equal(y))
+		txt, err := v.MarshalText()
+		require.NoError(t, err)
+		assert.EqualT(t, orig, string(txt))
+
+		var j Year
+		require.NoError(t, j.UnmarshalJSON(bj))
+		assert.TrueT(t, j.Equal(y))
+		assert.JSONMarshalAsT(t, string(bj), j)
+		require.NoError(t, j.UnmarshalJSON([]byte(jsonNull)))
+		require.Error(t, j.UnmarshalJSON([]byte(`"24"`)))
+		require.Error(t, j.UnmarshalJSON([]byte(`{}`)))
+
+		for _, value := range []any{orig, []byte(orig), int64(2024), time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)} {
+			var s Year
+			require.NoError(t, s.Scan(value))
+			assert.TrueT(t, s.Equal(y), "value: %#v", value)
+		}
+		var s Year
+		require.NoError(t, s.Scan(nil))
+		assert.TrueT(t, s.IsZero())
+		require.Error(t, s.Scan(2024.0))
+
+		dbv, err := y.Value()
+		require.NoError(t, err)
+		assert.EqualValues(t, orig, dbv)
+
+		out := y.DeepCopy()
+		assert.Equal(t, &y, out)
+		var inNil *Year
+		assert.Nil(t, inNil.DeepCopy())
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		testValid(t, "year", orig)
+		testValid(t, "year", "0000")
+		for _, value := range []string{"24", "20240", "-2024", "+2024", "yada"} {
+			testInvalid(t, "year", value)
+		}
+	})
+}
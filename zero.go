@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import "time"
+
+// Zero values and nulls.
+//
+// All formats follow the same policy:
+//
+//   - a JSON null leaves the value unchanged, like for any type of the standard library
+//   - empty text, e.g. UnmarshalText(""), a JSON "" or Scan(""), yields the zero value
+//   - a SQL NULL and a BSON null yield the zero value
+//   - the zero value is never rejected when [ValidateOnUnmarshal] is enabled
+//
+// The only exception is [DateTime]: for backward compatibility, empty text yields the unix epoch (see [NewDateTime]).
+//
+// Every format has an IsZero method, so the "omitzero" JSON tag option leaves zero values out.
+// Use [Nullable] to tell an absent or null value apart from the zero value.
+
+// IsZero returns true when the [URI] is empty.
+func (u URI) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [Email] is empty.
+func (e Email) IsZero() bool {
+	return e == ""
+}
+
+// IsZero returns true when the [Hostname] is empty.
+func (h Hostname) IsZero() bool {
+	return h == ""
+}
+
+// IsZero returns true when the [IPv4] is empty.
+func (u IPv4) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [IPv6] is empty.
+func (u IPv6) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [CIDR] is empty.
+func (u CIDR) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [MAC] is empty.
+func (u MAC) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [UUID] is empty.
+func (u UUID) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [UUID3] is empty.
+func (u UUID3) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [UUID4] is empty.
+func (u UUID4) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [UUID5] is empty.
+func (u UUID5) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [UUID7] is empty.
+func (u UUID7) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [ISBN] is empty.
+func (u ISBN) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [ISBN10] is empty.
+func (u ISBN10) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [ISBN13] is empty.
+func (u ISBN13) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [CreditCard] is empty.
+func (u CreditCard) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [SSN] is empty.
+func (u SSN) IsZero() bool {
+	return u == ""
+}
+
+// IsZero returns true when the [HexColor] is empty.
+func (h HexColor) IsZero() bool {
+	return h == ""
+}
+
+// IsZero returns true when the [RGBColor] is empty.
+func (r RGBColor) IsZero() bool {
+	return r == ""
+}
+
+// IsZero returns true when the [Password] is empty.
+func (p Password) IsZero() bool {
+	return p == ""
+}
+
+// IsZero returns true when the [Cron] is empty.
+func (c Cron) IsZero() bool {
+	return c == ""
+}
+
+// IsZero returns true when the [TimeZone] is empty.
+func (z TimeZone) IsZero() bool {
+	return z == ""
+}
+
+// IsZero returns true when the [Base64] holds no bytes.
+func (b Base64) IsZero() bool {
+	return len(b) == 0
+}
+
+// IsZero returns true for the zero [Date].
+func (d Date) IsZero() bool {
+	return time.Time(d).IsZero()
+}
+
+// IsZero returns true for a zero [Duration].
+func (d Duration) IsZero() bool {
+	return d == 0
+}
+
+// IsZero returns true for the nil [ObjectId].
+func (id ObjectId) IsZero() bool { //nolint:revive
+	return id == nilObjectID
+}
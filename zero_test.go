@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql"
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt/internal/bsonlite"
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+type zeroFormat interface {
+	IsZero() bool
+	encoding.TextUnmarshaler
+	json.Unmarshaler
+	sql.Scanner
+}
+
+func TestZeroPolicy(t *testing.T) {
+	withValidateOnUnmarshal(t)
+
+	nullDoc, err := bsonlite.C.MarshalDoc(nil)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		value zeroFormat
+		text  string
+	}{
+		{new(URI), "https://example.com"},
+		{new(Email), "john@example.com"},
+		{new(Hostname), "example.com"},
+		{new(IPv4), "192.0.2.1"},
+		{new(IPv6), "2001:db8::1"},
+		{new(CIDR), "192.0.2.0/24"},
+		{new(MAC), "01:23:45:67:89:ab"},
+		{new(UUID), "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{new(UUID3), "a3bb189e-8bf9-3888-9912-ace4e6543002"},
+		{new(UUID4), "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{new(UUID5), "a6edc906-2f9f-5fb2-a373-efac406f0ef2"},
+		{new(UUID7), "01890a5d-ac96-774b-bcce-b302099a8057"},
+		{new(ISBN), "9780306406157"},
+		{new(ISBN10), "0306406152"},
+		{new(ISBN13), "9780306406157"},
+		{new(CreditCard), "4111111111111111"},
+		{new(SSN), "123-45-6789"},
+		{new(HexColor), "#abc"},
+		{new(RGBColor), "rgb(1, 2, 3)"},
+		{new(Password), "secret"},
+		{new(Cron), "@daily"},
+		{new(TimeZone), "Europe/Paris"},
+		{new(Base64), "aGVsbG8="},
+		{new(Date), "2024-05-01"},
+		{new(CivilDate), "2024-05-01"},
+		{new(ExactDateTime), "2024-05-01T12:00:00Z"},
+		{new(ZonedDateTime), "2024-05-01T10:00:00+02:00[Europe/Paris]"},
+		{new(HTTPDate), "Sun, 06 Nov 1994 08:49:37 GMT"},
+		{new(Duration), "1h"},
+		{new(Interval), "2024-01-01/P1M"},
+		{new(RepeatingInterval), "R5/2024-01-01T00:00:00.000Z/PT1H"},
+		{new(YearMonth), "2024-05"},
+		{new(Year), "2024"},
+		{new(RRule), "FREQ=DAILY;COUNT=5"},
+		{new(ULID), "01EYXZVGBHG26MFTG4JWR4K558"},
+		{new(ObjectId), "507f1f77bcf86cd799439011"},
+	} {
+		v := tc.value
+		reset := func() {
+			t.Helper()
+			require.NoErrorf(t, v.UnmarshalText([]byte(tc.text)), "%T", v)
+			require.FalseTf(t, v.IsZero(), "%T", v)
+		}
+
+		reset()
+		require.NoErrorf(t, v.UnmarshalJSON([]byte(jsonNull)), "%T", v)
+		assert.FalseTf(t, v.IsZero(), "a JSON null should leave a %T unchanged", v)
+
+		require.NoErrorf(t, v.UnmarshalText(nil), "%T", v)
+		assert.TrueTf(t, v.IsZero(), "empty text should yield a zero %T", v)
+
+		reset()
+		require.NoErrorf(t, v.UnmarshalJSON([]byte(`""`)), "%T", v)
+		assert.TrueTf(t, v.IsZero(), "an empty JSON string should yield a zero %T", v)
+
+		reset()
+		require.NoErrorf(t, v.Scan(nil), "%T", v)
+		assert.TrueTf(t, v.IsZero(), "a SQL NULL should yield a zero %T", v)
+
+		reset()
+		require.NoErrorf(t, v.Scan(""), "%T", v)
+		assert.TrueTf(t, v.IsZero(), "an empty SQL string should yield a zero %T", v)
+
+		if b, ok := v.(bsonUnmarshaler); ok {
+			reset()
+			require.NoErrorf(t, b.UnmarshalBSON(nullDoc), "%T", v)
+			assert.TrueTf(t, v.IsZero(), "a BSON null should yield a zero %T", v)
+		}
+	}
+
+	t.Run("should keep the unix epoch for an empty DateTime", func(t *testing.T) {
+		var dt DateTime
+		require.NoError(t, dt.UnmarshalText(nil))
+		assert.TrueT(t, dt.IsUnixZero())
+
+		require.NoError(t, dt.Scan(nil))
+		assert.TrueT(t, dt.IsZero())
+
+		require.NoError(t, dt.UnmarshalBSON(nullDoc))
+		assert.TrueT(t, dt.IsZero())
+	})
+
+	t.Run("should decode an empty ULID as zero", func(t *testing.T) {
+		var result struct {
+			ULID *ULID `json:"ulid"`
+		}
+		d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook: NewFormats().MapStructureHookFunc(),
+			Result:     &result,
+		})
+		require.NoError(t, err)
+		require.NoError(t, d.Decode(map[string]any{"ulid": ""}))
+		require.NotNil(t, result.ULID)
+		assert.TrueT(t, result.ULID.IsZero())
+	})
+}
+
+func TestZero_ObjectIdText(t *testing.T) {
+	var id ObjectId
+	txt, err := id.MarshalText()
+	require.NoError(t, err)
+	assert.EqualT(t, "000000000000000000000000", string(txt))
+
+	js, err := id.MarshalJSON()
+	require.NoError(t, err)
+	assert.EqualT(t, `"`+string(txt)+`"`, string(js))
+
+	var back ObjectId
+	require.NoError(t, back.UnmarshalText(txt))
+	assert.TrueT(t, back.IsZero())
+}
+
+func TestZero_OmitZero(t *testing.T) {
+	type layout struct {
+		Email    Email    `json:"email,omitzero"`
+		Date     Date     `json:"date,omitzero"`
+		Duration Duration `json:"duration,omitzero"`
+		ID       ObjectId `json:"id,omitzero"`
+		Data     Base64   `json:"data,omitzero"`
+	}
+
+	js, err := json.Marshal(layout{})
+	require.NoError(t, err)
+	assert.EqualT(t, `{}`, string(js))
+
+	js, err = json.Marshal(layout{Email: "john@example.com"})
+	require.NoError(t, err)
+	assert.EqualT(t, `{"email":"john@example.com"}`, string(js))
+}
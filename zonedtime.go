@@ -0,0 +1,380 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	_ "time/tzdata" // time zone annotations are resolved against the embedded IANA database
+)
+
+func init() { //nolint:gochecknoinits // registers zoned-date-time format in the default registry
+	z := ZonedDateTime{}
+	Default.Add("zoned-date-time", &z, IsZonedDateTime)
+}
+
+// ErrZoneMismatch is raised when the offset of a date-time does not match its time zone annotation.
+const ErrZoneMismatch strfmtError = "offset does not match time zone"
+
+const (
+	// calendarKey is the suffix key for calendar annotations, e.g. "[u-ca=hebrew]".
+	calendarKey = "u-ca"
+
+	criticalFlag = '!'
+)
+
+// IsZonedDateTime returns true when the string is a valid RFC 9557 date-time, e.g. "2024-05-01T10:00:00+02:00[Europe/Paris]".
+func IsZonedDateTime(str string) bool {
+	_, err := ParseZonedDateTime(str)
+	return err == nil
+}
+
+// ZonedDateTime is a RFC 3339 date-time with RFC 9557 (IXDTF) suffix annotations.
+//
+// The time zone annotation, e.g. "2024-05-01T10:00:00+02:00[Europe/Paris]", resolves the time
+// in the named IANA location, so that computations on local time remain correct across daylight
+// saving time changes. The IANA database is embedded in the program.
+//
+// Other annotations, such as the calendar "[u-ca=hebrew]", are retained and marshaled back.
+// They do not alter the time, which always follows the ISO 8601 calendar.
+//
+// swagger:strfmt zoned-date-time.
+type ZonedDateTime struct {
+	time     time.Time
+	zone     string
+	critical bool
+	calendar string
+	tags     string
+}
+
+// NewZonedDateTime builds a [ZonedDateTime] annotated with the IANA location of t, e.g. "[Europe/Paris]".
+//
+// Times in the [time.Local] location or in a fixed zone are not annotated.
+func NewZonedDateTime(t time.Time) ZonedDateTime {
+	z := ZonedDateTime{time: t}
+	if loc := t.Location(); loc != time.Local { //nolint:gosmopolitan // Local is not a valid annotation
+		if _, err := time.LoadLocation(loc.String()); err == nil {
+			z.zone = loc.String()
+		}
+	}
+
+	return z
+}
+
+// ParseZonedDateTime parses a RFC 3339 date-time, optionally followed by RFC 9557 suffix annotations.
+//
+// The time zone annotation is either an IANA time zone name or a numerical offset, e.g. "[+02:00]".
+// When the offset of the date-time is "Z", the local offset is considered unknown and is derived from the time zone.
+// Otherwise, an offset which does not match the time zone yields an [ErrZoneMismatch] error.
+//
+// Suffix tags with an unknown key are retained, unless they are flagged as critical, e.g. "[!x-key=value]".
+func ParseZonedDateTime(data string) (ZonedDateTime, error) {
+	base, suffix := data, ""
+	if idx := strings.IndexByte(data, '['); idx >= 0 {
+		base, suffix = data[:idx], data[idx:]
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, strings.ToUpper(base))
+	if err != nil {
+		return ZonedDateTime{}, fmt.Errorf("invalid zoned date-time %q: %w: %w", data, err, ErrFormat)
+	}
+	unknownOffset := strings.HasSuffix(strings.ToUpper(base), "Z") || strings.HasSuffix(base, rfc3339UnknownOffset)
+
+	z := ZonedDateTime{time: t}
+	var tags strings.Builder
+	for first := true; suffix != ""; first = false {
+		end := strings.IndexByte(suffix, ']')
+		if suffix[0] != '[' || end < 0 {
+			return ZonedDateTime{}, fmt.Errorf("invalid zoned date-time %q: malformed annotation %q: %w", data, suffix, ErrFormat)
+		}
+		annotation := suffix[:end+1]
+		content := suffix[1:end]
+		suffix = suffix[end+1:]
+
+		critical := content != "" && content[0] == criticalFlag
+		if critical {
+			content = content[1:]
+		}
+
+		key, value, isTag := strings.Cut(content, "=")
+		if !isTag {
+			if !first {
+				return ZonedDateTime{}, fmt.Errorf("invalid zoned date-time %q: the time zone must be the first annotation: %w", data, ErrFormat)
+			}
+			if err := z.setZone(content, critical, unknownOffset); err != nil {
+				return ZonedDateTime{}, fmt.Errorf("invalid zoned date-time %q: %w", data, err)
+			}
+			continue
+		}
+
+		if !isSuffixKey(key) || !isSuffixValue(value) {
+			return ZonedDateTime{}, fmt.Errorf("invalid zoned date-time %q: malformed annotation %q: %w", data, annotation, ErrFormat)
+		}
+
+		if err := z.setTag(key, value, critical); err != nil {
+			return ZonedDateTime{}, fmt.Errorf("invalid zoned date-time %q: annotation %q: %w", data, annotation, err)
+		}
+		tags.WriteString(annotation)
+	}
+	z.tags = tags.String()
+
+	return z, nil
+}
+
+// setZone resolves the time in the location of a time zone annotation.
+func (z *ZonedDateTime) setZone(zone string, critical, unknownOffset bool) error {
+	var loc *time.Location
+	if zone != "" && (zone[0] == '+' || zone[0] == '-') {
+		offset, err := time.Parse("-07:00", zone)
+		if err != nil {
+			return fmt.Errorf("invalid time zone offset %q: %w", zone, ErrFormat)
+		}
+		_, secs := offset.Zone()
+		loc = time.FixedZone("", secs)
+	} else {
+		if zone == "" || zone == "Local" || !isTimeZoneName(zone) {
+			return fmt.Errorf("invalid time zone %q: %w", zone, ErrFormat)
+		}
+		var err error
+		loc, err = time.LoadLocation(zone)
+		if err != nil {
+			return fmt.Errorf("unknown time zone %q: %w", zone, ErrFormat)
+		}
+	}
+
+	zoned := z.time.In(loc)
+	if !unknownOffset {
+		_, offset := z.time.Zone()
+		if _, zoneOffset := zoned.Zone(); offset != zoneOffset {
+			return fmt.Errorf("offset %s vs %s in %q: %w: %w",
+				z.time.Format("-07:00"), zoned.Format("-07:00"), zone, ErrZoneMismatch, ErrFormat)
+		}
+	}
+
+	z.time = zoned
+	z.zone = zone
+	z.critical = critical
+
+	return nil
+}
+
+// setTag interprets a suffix tag.
+//
+// Only the first calendar annotation is retained. Critical tags which cannot be honored are rejected.
+func (z *ZonedDateTime) setTag(key, value string, critical bool) error {
+	switch {
+	case key == calendarKey && z.calendar == "":
+		if critical && !isISOCalendar(value) {
+			return fmt.Errorf("unsupported calendar %q: %w", value, ErrFormat)
+		}
+		z.calendar = value
+	case key == calendarKey && critical:
+		return fmt.Errorf("duplicate critical calendar: %w", ErrFormat)
+	case critical:
+		return fmt.Errorf("unsupported critical annotation: %w", ErrFormat)
+	}
+
+	return nil
+}
+
+// isTimeZoneName checks the syntax of an IANA time zone name, as defined by RFC 9557.
+func isTimeZoneName(name string) bool {
+	for part := range strings.SplitSeq(name, "/") {
+		if part == "" || part == "." || part == ".." {
+			return false
+		}
+		for i := range len(part) {
+			c := part[i]
+			switch {
+			case isASCIIAlpha(c), c == '.', c == '_':
+			case i > 0 && (isASCIIDigit(c) || c == '-' || c == '+'):
+			default:
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// isSuffixKey checks the syntax of a suffix key, e.g. "u-ca".
+func isSuffixKey(key string) bool {
+	if key == "" || (key[0] != '_' && (key[0] < 'a' || key[0] > 'z')) {
+		return false
+	}
+	for i := 1; i < len(key); i++ {
+		if c := key[i]; c != '_' && c != '-' && (c < 'a' || c > 'z') && !isASCIIDigit(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSuffixValue checks the syntax of suffix values, e.g. "islamic-civil".
+func isSuffixValue(value string) bool {
+	for part := range strings.SplitSeq(value, "-") {
+		if part == "" {
+			return false
+		}
+		for i := range len(part) {
+			if c := part[i]; !isASCIIAlpha(c) && !isASCIIDigit(c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// isISOCalendar tells if a calendar identifier stands for the calendar of ISO 8601.
+func isISOCalendar(calendar string) bool {
+	return calendar == "iso8601" || calendar == "gregory"
+}
+
+func isASCIIAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// Time returns the time, in the location of the time zone annotation if any.
+func (z ZonedDateTime) Time() time.Time {
+	return z.time
+}
+
+// DateTime returns the time as a [DateTime].
+func (z ZonedDateTime) DateTime() DateTime {
+	return DateTime(z.time)
+}
+
+// Zone returns the time zone annotation, e.g. "Europe/Paris" or "+02:00", or an empty string.
+func (z ZonedDateTime) Zone() string {
+	return z.zone
+}
+
+// Location returns the location of the time.
+func (z ZonedDateTime) Location() *time.Location {
+	return z.time.Location()
+}
+
+// Calendar returns the calendar annotation, e.g. "hebrew", or an empty string.
+func (z ZonedDateTime) Calendar() string {
+	return z.calendar
+}
+
+// IsZero returns whether the date-time is a zero value.
+func (z ZonedDateTime) IsZero() bool {
+	return z.time.IsZero()
+}
+
+// Equal checks if two [ZonedDateTime] instances represent the same instant in the same time zone.
+func (z ZonedDateTime) Equal(other ZonedDateTime) bool {
+	return z.time.Equal(other.time) && z.zone == other.zone
+}
+
+// String converts this date-time to a string, e.g. "2024-05-01T10:00:00+02:00[Europe/Paris][u-ca=gregory]".
+func (z ZonedDateTime) String() string {
+	var w strings.Builder
+	w.WriteString(z.time.Format(time.RFC3339Nano))
+	if z.zone != "" {
+		w.WriteByte('[')
+		if z.critical {
+			w.WriteByte(criticalFlag)
+		}
+		w.WriteString(z.zone)
+		w.WriteByte(']')
+	}
+	w.WriteString(z.tags)
+
+	return w.String()
+}
+
+// MarshalText implements the text marshaler interface.
+func (z ZonedDateTime) MarshalText() ([]byte, error) {
+	return []byte(z.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaler interface.
+func (z *ZonedDateTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*z = ZonedDateTime{}
+		return nil
+	}
+	v, err := ParseZonedDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*z = v
+	return nil
+}
+
+// Scan scans a [ZonedDateTime] value from database driver type.
+func (z *ZonedDateTime) Scan(raw any) error {
+	switch v := raw.(type) {
+	case []byte:
+		return z.UnmarshalText(v)
+	case string:
+		return z.UnmarshalText([]byte(v))
+	case time.Time:
+		*z = NewZonedDateTime(v)
+	case nil:
+		*z = ZonedDateTime{}
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.ZonedDateTime from: %#v: %w", v, ErrFormat)
+	}
+
+	return nil
+}
+
+// Value converts [ZonedDateTime] to a primitive value ready to written to a database.
+//
+// The value is a string, so the annotations are preserved.
+func (z ZonedDateTime) Value() (driver.Value, error) {
+	return driver.Value(z.String()), nil
+}
+
+// MarshalJSON returns the [ZonedDateTime] as JSON.
+func (z ZonedDateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(z.String())
+}
+
+// UnmarshalJSON sets the [ZonedDateTime] from JSON.
+func (z *ZonedDateTime) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return z.UnmarshalText([]byte(str))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (z *ZonedDateTime) DeepCopyInto(out *ZonedDateTime) {
+	*out = *z
+}
+
+// DeepCopy copies the receiver into a new [ZonedDateTime].
+func (z *ZonedDateTime) DeepCopy() *ZonedDateTime {
+	if z == nil {
+		return nil
+	}
+	out := new(ZonedDateTime)
+	z.DeepCopyInto(out)
+	return out
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (z ZonedDateTime) GobEncode() ([]byte, error) {
+	return z.MarshalText()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (z *ZonedDateTime) GobDecode(data []byte) error {
+	return z.UnmarshalText(data)
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: Copyright 2015-2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/testify/v2/assert"
+	"github.com/go-openapi/testify/v2/require"
+)
+
+func TestZonedDateTime_Parse(t *testing.T) {
+	t.Run("should resolve the time in the named location", func(t *testing.T) {
+		z, err := ParseZonedDateTime("2024-05-01T10:00:00+02:00[Europe/Paris]")
+		require.NoError(t, err)
+
+		assert.EqualT(t, "Europe/Paris", z.Zone())
+		assert.EqualT(t, "Europe/Paris", z.Location().String())
+		assert.EqualT(t, 10, z.Time().Hour())
+		assert.EqualT(t, "", z.Calendar())
+
+		// the local time follows daylight saving time changes
+		winter := z.Time().AddDate(0, 6, 0)
+		assert.EqualT(t, 10, winter.Hour())
+		_, offset := winter.Zone()
+		assert.EqualT(t, 3600, offset)
+	})
+
+	t.Run("should derive the offset when the local offset is unknown", func(t *testing.T) {
+		z, err := ParseZonedDateTime("2024-05-01T08:00:00Z[Europe/Paris]")
+		require.NoError(t, err)
+		assert.EqualT(t, 10, z.Time().Hour())
+		assert.EqualT(t, "2024-05-01T10:00:00+02:00[Europe/Paris]", z.String())
+	})
+
+	t.Run("should report offset mismatches", func(t *testing.T) {
+		_, err := ParseZonedDateTime("2024-05-01T10:00:00+01:00[Europe/Paris]")
+		require.ErrorIs(t, err, ErrZoneMismatch)
+		require.ErrorIs(t, err, ErrFormat)
+
+		_, err = ParseZonedDateTime("2024-05-01T10:00:00+01:00[+02:00]")
+		require.ErrorIs(t, err, ErrZoneMismatch)
+	})
+
+	t.Run("should support numerical offsets", func(t *testing.T) {
+		z, err := ParseZonedDateTime("2024-05-01T10:00:00+05:30[+05:30]")
+		require.NoError(t, err)
+		assert.EqualT(t, "+05:30", z.Zone())
+		assert.EqualT(t, "2024-05-01T10:00:00+05:30[+05:30]", z.String())
+	})
+
+	t.Run("should retain suffix tags", func(t *testing.T) {
+		z, err := ParseZonedDateTime("2024-05-01T10:00:00.5+02:00[!Europe/Paris][u-ca=hebrew][_foo=bar-baz]")
+		require.NoError(t, err)
+		assert.EqualT(t, "hebrew", z.Calendar())
+		assert.EqualT(t, "2024-05-01T10:00:00.5+02:00[!Europe/Paris][u-ca=hebrew][_foo=bar-baz]", z.String())
+
+		z, err = ParseZonedDateTime("2024-05-01T10:00:00Z[u-ca=gregory]")
+		require.NoError(t, err)
+		assert.EqualT(t, "", z.Zone())
+		assert.EqualT(t, "gregory", z.Calendar())
+		assert.EqualT(t, "2024-05-01T10:00:00Z[u-ca=gregory]", z.String())
+	})
+
+	t.Run("should accept plain RFC 3339 date-times", func(t *testing.T) {
+		z, err := ParseZonedDateTime("2024-05-01t10:00:00z")
+		require.NoError(t, err)
+		assert.EqualT(t, "2024-05-01T10:00:00Z", z.String())
+	})
+
+	for _, input := range []string{
+		"",
+		"2024-05-01",
+		"2024-05-01T10:00:00[Europe/Paris]",
+		"2024-05-01T10:00:00+02:00[Europe/Nowhere]",
+		"2024-05-01T10:00:00+02:00[Local]",
+		"2024-05-01T10:00:00+02:00[]",
+		"2024-05-01T10:00:00+02:00[Europe/Paris",
+		"2024-05-01T10:00:00+02:00[Europe/Paris]junk",
+		"2024-05-01T10:00:00+02:00[../etc/passwd]",
+		"2024-05-01T10:00:00+02:00[u-ca=hebrew][Europe/Paris]",
+		"2024-05-01T10:00:00+02:00[Europe/Paris][U-CA=hebrew]",
+		"2024-05-01T10:00:00+02:00[Europe/Paris][u-ca=]",
+		"2024-05-01T10:00:00+02:00[Europe/Paris][u-ca=a--b]",
+		"2024-05-01T10:00:00+02:00[Europe/Paris][!u-ca=hebrew]",
+		"2024-05-01T10:00:00+02:00[Europe/Paris][u-ca=iso8601][!u-ca=gregory]",
+		"2024-05-01T10:00:00+02:00[Europe/Paris][!x-unknown=value]",
+		"2024-05-01T10:00:00+02:00[+2:00]",
+	} {
+		t.Run("should reject "+input, func(t *testing.T) {
+			_, err := ParseZonedDateTime(input)
+			require.ErrorIs(t, err, ErrFormat)
+			assert.FalseT(t, IsZonedDateTime(input))
+		})
+	}
+}
+
+func TestZonedDateTime_Marshaling(t *testing.T) {
+	const input = "2024-05-01T10:00:00.123+02:00[Europe/Paris][u-ca=iso8601]"
+
+	z, err := ParseZonedDateTime(input)
+	require.NoError(t, err)
+	assert.TrueT(t, IsZonedDateTime(input))
+
+	b, err := json.Marshal(z)
+	require.NoError(t, err)
+	assert.EqualT(t, `"`+input+`"`, string(b))
+
+	var back ZonedDateTime
+	require.NoError(t, json.Unmarshal(b, &back))
+	assert.TrueT(t, z.Equal(back))
+	assert.EqualT(t, input, back.String())
+
+	txt, err := z.MarshalText()
+	require.NoError(t, err)
+	assert.EqualT(t, input, string(txt))
+
+	v, err := z.Value()
+	require.NoError(t, err)
+	assert.Equal(t, input, v)
+
+	var scanned ZonedDateTime
+	require.NoError(t, scanned.Scan(input))
+	assert.TrueT(t, z.Equal(scanned))
+	require.NoError(t, scanned.Scan([]byte(input)))
+	assert.TrueT(t, z.Equal(scanned))
+	require.NoError(t, scanned.Scan(nil))
+	assert.TrueT(t, scanned.IsZero())
+	require.ErrorIs(t, scanned.Scan(int64(1)), ErrFormat)
+
+	bsonData, err := z.MarshalBSON()
+	require.NoError(t, err)
+	var fromBSON ZonedDateTime
+	require.NoError(t, fromBSON.UnmarshalBSON(bsonData))
+	assert.EqualT(t, input, fromBSON.String())
+
+	out := z.DeepCopy()
+	assert.TrueT(t, z.Equal(*out))
+	var nilZ *ZonedDateTime
+	assert.Nil(t, nilZ.DeepCopy())
+}
+
+func TestNewZonedDateTime(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	z := NewZonedDateTime(time.Date(2024, 1, 15, 9, 30, 0, 0, loc))
+	assert.EqualT(t, "2024-01-15T09:30:00-05:00[America/New_York]", z.String())
+	assert.TrueT(t, z.DateTime().Equal(DateTime(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC))))
+
+	fixed := NewZonedDateTime(time.Date(2024, 1, 15, 9, 30, 0, 0, time.FixedZone("", 3600)))
+	assert.EqualT(t, "2024-01-15T09:30:00+01:00", fixed.String())
+
+	utc := NewZonedDateTime(time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC))
+	assert.EqualT(t, "2024-01-15T09:30:00Z[UTC]", utc.String())
+}